@@ -0,0 +1,17 @@
+// Command viewappend runs the viewappend analyzer, reporting unsafe use of bulk results which may alias their input.
+//
+// Usage:
+//
+//	go install github.com/go-analyze/bulk/analysis/cmd/viewappend@latest
+//	viewappend ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/go-analyze/bulk/analysis/viewappend"
+)
+
+func main() {
+	singlechecker.Main(viewappend.Analyzer)
+}
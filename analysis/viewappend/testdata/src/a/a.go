@@ -0,0 +1,217 @@
+package a
+
+import "github.com/go-analyze/bulk"
+
+type record struct {
+	id   int
+	name string
+}
+
+func even(v int) bool { return v%2 == 0 }
+
+func appendToFilter(data []int) []int {
+	evens := bulk.SliceFilter(even, data)
+	evens = append(evens, 99) // want `append to result of bulk.SliceFilter which may be a view of its input; use bulk.SliceFilterCopy`
+	return evens
+}
+
+func appendAfterReassign(data []int) []int {
+	evens := bulk.SliceFilter(even, data)
+	evens = make([]int, len(evens))
+	return append(evens, 1)
+}
+
+func appendToCopy(data []int) []int {
+	evens := bulk.SliceFilterCopy(even, data)
+	return append(evens, 99)
+}
+
+func splitWrites(data []int) {
+	evens, odds := bulk.SliceSplit(even, data)
+	evens[0] = 1     // want `element write to result of bulk.SliceSplit which may be a view of its input; use bulk.SliceSplitCopy`
+	odds[0]++        // want `element write to result of bulk.SliceSplit which may be a view of its input; use bulk.SliceSplitCopy`
+	copy(odds, data) // want `copy into result of bulk.SliceSplit which may be a view of its input; use bulk.SliceSplitCopy`
+}
+
+func structFieldWrite(a, b []record) {
+	common := bulk.SliceIntersect(a, b)
+	common[0].name = "x" // want `element write to result of bulk.SliceIntersect which may be a view of its input; use bulk.SliceIntersectCopy`
+}
+
+func pointerFieldWrite(a []*record) {
+	filtered := bulk.SliceFilter(func(r *record) bool { return r.id > 0 }, a)
+	filtered[0].name = "shared either way"
+}
+
+func readOnly(a, b []int) int {
+	diff := bulk.SliceDifference(a, b)
+	total := 0
+	for _, v := range diff {
+		total += v
+	}
+	return total + diff[0]
+}
+
+func useAfterInPlace(data []int) []int {
+	evens := bulk.SliceFilterInPlace(even, data)
+	_ = data[0] // want `data is used after being passed to bulk.SliceFilterInPlace, which may have modified it`
+	return evens
+}
+
+func reassignInPlace(data []int) []int {
+	data = bulk.SliceFilterInPlace(even, data)
+	return append(data, 1)
+}
+
+func useAfterSplitInPlace(data []int) int {
+	evens, odds := bulk.SliceSplitInPlace(even, data)
+	return len(evens) + len(odds) + len(data) // want `data is used after being passed to bulk.SliceSplitInPlace, which may have modified it`
+}
+
+func appendToIndexed(data []int) []int {
+	tail := bulk.SliceFilterIndexed(func(_, elemIdx int, _ int) bool { return elemIdx > 0 }, data)
+	return append(tail, 1) // want `append to result of bulk.SliceFilterIndexed which may be a view of its input$`
+}
+
+func writeToSplit3(data []int) {
+	_, equal, _ := bulk.SliceSplit3(func(v int) int { return v - 3 }, data)
+	equal[0] = 0 // want `element write to result of bulk.SliceSplit3 which may be a view of its input$`
+}
+
+func removeIndexes(data []int, idxs []int) []int {
+	remaining := bulk.SliceRemoveIndexes(data, idxs)
+	remaining = append(remaining, 1) // want `append to result of bulk.SliceRemoveIndexes which may be a view of its input$`
+	data = bulk.SliceRemoveIndexesInPlace(data, idxs)
+	return append(data, idxs...) // idxs is not modified by the InPlace call
+}
+
+func useAfterRemoveInPlace(data []int, idxs []int) int {
+	remaining := bulk.SliceRemoveIndexesInPlace(data, idxs)
+	return len(remaining) + len(idxs) + data[0] // want `data is used after being passed to bulk.SliceRemoveIndexesInPlace, which may have modified it`
+}
+
+func sortedEdits(data []int) []int {
+	remaining, _ := bulk.SortedRemove(data, 3)
+	remaining = append(remaining, 1) // want `append to result of bulk.SortedRemove which may be a view of its input$`
+	data = bulk.SortedInsertInPlace(data, 2)
+	return append(data, remaining...)
+}
+
+func useAfterSortedInsertInPlace(data []int) int {
+	inserted := bulk.SortedInsertInPlace(data, 2)
+	return len(inserted) + data[0] // want `data is used after being passed to bulk.SortedInsertInPlace, which may have modified it`
+}
+
+func inPlaceInReturningBranch(f bool, data []int) []int {
+	if f {
+		return bulk.SliceFilterInPlace(func(v int) bool { return v > 0 }, data)
+	}
+	return data // the InPlace call cannot reach here
+}
+
+func inPlaceInPanickingBranch(f bool, data []int) []int {
+	if f {
+		_ = bulk.SliceFilterInPlace(func(v int) bool { return v > 0 }, data)
+		panic("unreachable")
+	}
+	return data
+}
+
+func inPlaceInReturningCase(n int, data []int) []int {
+	switch n {
+	case 0:
+		return bulk.SliceFilterInPlace(func(v int) bool { return v > 0 }, data)
+	case 1:
+		_ = bulk.SliceFilterInPlace(func(v int) bool { return v > 1 }, data)
+	}
+	return data // want `data is used after being passed to bulk.SliceFilterInPlace, which may have modified it`
+}
+
+func inPlaceInFallthroughBranch(f bool, data []int) []int {
+	if f {
+		_ = bulk.SliceFilterInPlace(func(v int) bool { return v > 0 }, data)
+	}
+	return data // want `data is used after being passed to bulk.SliceFilterInPlace, which may have modified it`
+}
+
+func viewInReturningBranch(f bool, data []int) []int {
+	evens := data
+	if f {
+		evens = bulk.SliceFilter(func(v int) bool { return v%2 == 0 }, data)
+		return evens
+	}
+	return append(evens, 1)
+}
+
+func viewDeclared(data []int) []int {
+	var evens = bulk.SliceFilter(func(v int) bool { return v%2 == 0 }, data)
+	var odds, others []int = bulk.SliceSplit(func(v int) bool { return v%2 != 0 }, data)
+	odds = append(odds, others...) // want `append to result of bulk.SliceSplit which may be a view of its input; use bulk.SliceSplitCopy`
+	return append(evens, 1)        // want `append to result of bulk.SliceFilter which may be a view of its input; use bulk.SliceFilterCopy`
+}
+
+func viewDeclaredThenReassigned(data []int) []int {
+	var evens = bulk.SliceFilter(func(v int) bool { return v%2 == 0 }, data)
+	evens = bulk.SliceFilterCopy(func(v int) bool { return v%2 == 0 }, evens)
+	return append(evens, 1)
+}
+
+func useAfterInPlaceDeclared(data []int) int {
+	var filtered = bulk.SliceFilterInPlace(func(v int) bool { return v > 0 }, data)
+	return len(filtered) + data[0] // want `data is used after being passed to bulk.SliceFilterInPlace, which may have modified it`
+}
+
+func level(v int) int { return v % 3 }
+
+func appendToBucket(data []int) [][]int {
+	buckets := bulk.SlicePartitionN(level, 3, data)
+	buckets[0] = append(buckets[0], 1) // want `append to result of bulk.SlicePartitionN which may be a view of its input$`
+	buckets[1][0] = 2                  // want `element write to result of bulk.SlicePartitionN which may be a view of its input$`
+	buckets[2] = nil
+	return append(buckets, nil) // the outer slice is always allocated
+}
+
+func bucketVariables(data []int) int {
+	buckets := bulk.SlicePartitionNInPlaceUnstable(level, 3, data)
+	low := buckets[0]
+	low = append(low, 1) // want `append to result of bulk.SlicePartitionNInPlaceUnstable which may be a view of its input$`
+	var total int
+	for i, bucket := range buckets {
+		bucket[0] = i // want `element write to result of bulk.SlicePartitionNInPlaceUnstable which may be a view of its input$`
+		total += len(bucket)
+	}
+	return total + len(low) + len(data) // want `data is used after being passed to bulk.SlicePartitionNInPlaceUnstable, which may have modified it`
+}
+
+func filterOfSlices(data [][]int) [][]int {
+	nonEmpty := bulk.SliceFilter(func(v []int) bool { return len(v) > 0 }, data)
+	first := append(nonEmpty[0], 1) // elements are the caller's slices, only the outer slice may be a view
+	return append(nonEmpty, first)  // want `append to result of bulk.SliceFilter which may be a view of its input; use bulk.SliceFilterCopy`
+}
+
+func appendToShard(records []record) [][]record {
+	byName := func(r record) string { return r.name }
+	shards := bulk.SliceShardBy(4, byName, records)
+	shards[0] = append(shards[0], record{}) // want `append to result of bulk.SliceShardBy which may be a view of its input$`
+	consistent := bulk.SliceShardConsistentBy(4, byName, records)
+	for _, shard := range consistent {
+		shard[0].id = 0 // want `element write to result of bulk.SliceShardConsistentBy which may be a view of its input$`
+	}
+	return shards
+}
+
+func double(v int) int { return v * 2 }
+
+func useAfterTransformInPlace(data []int) int {
+	bulk.SliceTransformInPlace(double, data)
+	_, err := bulk.SliceTransformErrInPlace(func(v int) (int, error) { return v + 1, nil }, data)
+	if err != nil {
+		return 0
+	}
+	return data[0] // the input holds the transformed values
+}
+
+func useAfterFilterTransformInPlace(data []int) int {
+	kept := bulk.SliceFilterTransformInPlace(even, double, data)
+	return len(kept) + data[0] // want `data is used after being passed to bulk.SliceFilterTransformInPlace, which may have modified it`
+}
@@ -0,0 +1,19 @@
+package a
+
+import . "github.com/go-analyze/bulk"
+
+func dotImportedView(data []int) []int {
+	evens := SliceFilter(even, data)
+	evens[0] = 1             // want `element write to result of bulk.SliceFilter which may be a view of its input; use bulk.SliceFilterCopy`
+	return append(evens, 99) // want `append to result of bulk.SliceFilter which may be a view of its input; use bulk.SliceFilterCopy`
+}
+
+func dotImportedInPlace(data []int) int {
+	evens := SliceFilterInPlace(even, data)
+	return len(evens) + data[0] // want `data is used after being passed to bulk.SliceFilterInPlace, which may have modified it`
+}
+
+func dotImportedInstantiated(data []int) []int {
+	evens := SliceFilter[int](even, data)
+	return append(evens, 99) // want `append to result of bulk.SliceFilter which may be a view of its input; use bulk.SliceFilterCopy`
+}
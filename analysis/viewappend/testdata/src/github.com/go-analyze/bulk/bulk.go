@@ -0,0 +1,52 @@
+// Package bulk is a minimal stub of github.com/go-analyze/bulk for analyzer tests.
+package bulk
+
+func SliceFilter[T any](predicate func(val T) bool, slices ...[]T) []T { return nil }
+
+func SliceFilterCopy[T any](predicate func(val T) bool, slices ...[]T) []T { return nil }
+
+func SliceSplit[T any](predicate func(val T) bool, slices ...[]T) ([]T, []T) { return nil, nil }
+
+func SliceIntersect[T comparable](a, b []T) []T { return nil }
+
+func SliceDifference[T comparable](a, b []T) []T { return nil }
+
+func SliceFilterInPlace[T any](predicate func(val T) bool, slices ...[]T) []T { return nil }
+
+func SliceSplitInPlace[T any](predicate func(val T) bool, slice []T) ([]T, []T) { return nil, nil }
+
+func SliceFilterIndexed[T any](predicate func(sliceIdx, elemIdx int, val T) bool, slices ...[]T) []T {
+	return nil
+}
+
+func SliceSplit3[T any](compare func(val T) int, slices ...[]T) ([]T, []T, []T) { return nil, nil, nil }
+
+func SliceRemoveIndexes[T any](slice []T, idxs []int) []T { return nil }
+
+func SliceRemoveIndexesInPlace[T any](slice []T, idxs []int) []T { return nil }
+
+func SortedRemove[T any](slice []T, val T) ([]T, bool) { return nil, false }
+
+func SortedInsertInPlace[T any](slice []T, val T) []T { return nil }
+
+func SlicePartitionN[T any](classify func(val T) int, n int, slices ...[]T) [][]T { return nil }
+
+func SlicePartitionNInPlaceUnstable[T any](classify func(val T) int, n int, slice []T) [][]T {
+	return nil
+}
+
+func SliceShardBy[T any, K comparable](n int, keyfunc func(T) K, slices ...[]T) [][]T { return nil }
+
+func SliceShardConsistentBy[T any, K comparable](n int, keyfunc func(T) K, slices ...[]T) [][]T {
+	return nil
+}
+
+func SliceTransformInPlace[T any](conversion func(T) T, slice []T) []T { return nil }
+
+func SliceTransformErrInPlace[T any](conversion func(T) (T, error), slice []T) ([]T, error) {
+	return nil, nil
+}
+
+func SliceFilterTransformInPlace[T any](predicate func(T) bool, transform func(T) T, slice []T) []T {
+	return nil
+}
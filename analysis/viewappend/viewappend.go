@@ -0,0 +1,413 @@
+// Package viewappend defines an Analyzer that reports unsafe use of slices returned from bulk functions.
+//
+// Base bulk functions such as SliceFilter and SliceSplit may return a view of their input rather than a copy.
+// Appending to, or writing elements of, such a result can silently modify the original input. Similarly, InPlace
+// functions reuse the memory of their input, so the input must be discarded after the call.
+//
+// The analysis tracks local variables assigned from view-returning bulk functions and reports append calls, copy
+// destinations, and element writes on them. For functions returning buckets ([][]T), each bucket may be a view, so the
+// checks apply to the bucket elements and to variables assigned from them. It also reports any use of a variable after
+// it was passed to an InPlace function, unless the variable was first reassigned. InPlace transforms which store each
+// result at its own position, such as SliceTransformInPlace, leave the input valid and are not tracked.
+//
+// Tracking follows source order within a function. State changes within a block which ends in a return or panic are
+// discarded when leaving the block, since they cannot reach the statements which follow it. Other control flow is not
+// modeled.
+package viewappend
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const bulkPkgPath = "github.com/go-analyze/bulk"
+
+// Analyzer reports appends and element writes on possibly aliased bulk results, and use of slices after InPlace calls.
+var Analyzer = &analysis.Analyzer{
+	Name: "viewappend",
+	Doc:  "report appends and writes to bulk results that may alias their input, and use of slices after InPlace calls",
+	Run:  run,
+}
+
+// viewFuncs maps bulk functions which may return a view of their input to their copying counterpart, if one exists.
+var viewFuncs = map[string]string{
+	"SliceFilter":        "SliceFilterCopy",
+	"SliceFilterIndexed": "",
+	"SliceSplit":         "SliceSplitCopy",
+	"SliceSplitIndexed":  "",
+	"SliceSplit3":        "",
+	"SliceIntersect":     "SliceIntersectCopy",
+	"SliceDifference":    "SliceDifferenceCopy",
+	"SliceRemoveIndexes": "",
+	"SortedRemove":       "",
+	// buckets of these results may be views
+	"SlicePartitionN":                "",
+	"SlicePartitionNInPlaceUnstable": "",
+	"SliceShardBy":                   "",
+	"SliceShardConsistentBy":         "",
+}
+
+// sameSliceFuncs are InPlace functions which store each result at the position of its input element and return the
+// input slice itself, so the input remains valid and holds the results after the call.
+var sameSliceFuncs = map[string]bool{
+	"SliceTransformInPlace":    true,
+	"SliceTransformErrInPlace": true,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+				w := &walker{
+					pass:     pass,
+					views:    make(map[types.Object]viewState),
+					consumed: make(map[types.Object]consumedState),
+				}
+				w.walk(fn.Body)
+			}
+		}
+	}
+	return nil, nil
+}
+
+// viewState describes a variable holding a possibly aliased bulk result.
+type viewState struct {
+	funcName string
+	nested   bool // the variable holds buckets which may each be a view, rather than being a view itself
+}
+
+type consumedState struct {
+	funcName string
+	end      token.Pos
+}
+
+// walker visits a function body in source order, tracking variables which hold possibly aliased bulk results and
+// variables which were passed to InPlace functions.
+type walker struct {
+	pass     *analysis.Pass
+	views    map[types.Object]viewState     // variable -> view-returning bulk function it was assigned from
+	consumed map[types.Object]consumedState // variable -> InPlace bulk function it was passed to
+}
+
+func (w *walker) walk(node ast.Node) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			w.block(n.List)
+			return false
+		case *ast.CaseClause:
+			for _, expr := range n.List {
+				w.walk(expr)
+			}
+			w.block(n.Body)
+			return false
+		case *ast.CommClause:
+			if n.Comm != nil {
+				w.walk(n.Comm)
+			}
+			w.block(n.Body)
+			return false
+		case *ast.AssignStmt:
+			w.assign(n)
+			return false
+		case *ast.ValueSpec:
+			w.valueSpec(n)
+			return false
+		case *ast.RangeStmt:
+			w.rangeStmt(n)
+			return false
+		case *ast.IncDecStmt:
+			w.checkElementWrite(n.X)
+			w.walk(n.X)
+			return false
+		case *ast.CallExpr:
+			w.call(n)
+		case *ast.Ident:
+			w.use(n)
+		}
+		return true
+	})
+}
+
+// block walks the statements of a block. If the block ends by leaving the function, any state changes made within it
+// are discarded once the block is complete.
+func (w *walker) block(stmts []ast.Stmt) {
+	if len(stmts) == 0 || !w.terminates(stmts[len(stmts)-1]) {
+		for _, stmt := range stmts {
+			w.walk(stmt)
+		}
+		return
+	}
+
+	views := make(map[types.Object]viewState, len(w.views))
+	for obj, view := range w.views {
+		views[obj] = view
+	}
+	consumed := make(map[types.Object]consumedState, len(w.consumed))
+	for obj, state := range w.consumed {
+		consumed[obj] = state
+	}
+	for _, stmt := range stmts {
+		w.walk(stmt)
+	}
+	w.views, w.consumed = views, consumed
+}
+
+// terminates returns true if the statement returns from the function or panics.
+func (w *walker) terminates(stmt ast.Stmt) bool {
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.ExprStmt:
+		if call, ok := unparen(s.X).(*ast.CallExpr); ok {
+			if id, ok := unparen(call.Fun).(*ast.Ident); ok {
+				b, ok := w.pass.TypesInfo.Uses[id].(*types.Builtin)
+				return ok && b.Name() == "panic"
+			}
+		}
+	}
+	return false
+}
+
+// assign evaluates the right hand side before updating state for the assigned variables, matching Go semantics for
+// statements such as `s = bulk.SliceFilterInPlace(pred, s)`.
+func (w *walker) assign(stmt *ast.AssignStmt) {
+	for _, rhs := range stmt.Rhs {
+		w.walk(rhs)
+	}
+
+	view := w.viewValue(stmt.Rhs)
+	for _, lhs := range stmt.Lhs {
+		id, ok := unparen(lhs).(*ast.Ident)
+		if !ok {
+			w.checkElementWrite(lhs)
+			w.walk(lhs)
+			continue
+		}
+		obj := w.object(id)
+		if obj == nil {
+			continue
+		}
+		if stmt.Tok != token.ASSIGN && stmt.Tok != token.DEFINE {
+			w.use(id) // compound assignment reads the variable
+			continue
+		}
+		w.bind(obj, view)
+	}
+}
+
+// valueSpec handles variable declarations such as `var evens = bulk.SliceFilter(pred, data)` like assignments.
+func (w *walker) valueSpec(spec *ast.ValueSpec) {
+	for _, val := range spec.Values {
+		w.walk(val)
+	}
+	view := w.viewValue(spec.Values)
+	for _, id := range spec.Names {
+		if obj := w.object(id); obj != nil {
+			w.bind(obj, view)
+		}
+	}
+}
+
+// rangeStmt binds the value variable of a range over buckets, such as `for _, bucket := range buckets`, as a view.
+func (w *walker) rangeStmt(stmt *ast.RangeStmt) {
+	w.walk(stmt.X)
+	var view viewState
+	if outer, ok := w.viewOf(stmt.X); ok && outer.nested {
+		view = viewState{funcName: outer.funcName}
+	}
+	for i, expr := range []ast.Expr{stmt.Key, stmt.Value} {
+		id, ok := expr.(*ast.Ident) // nil when omitted
+		if !ok {
+			continue
+		} else if obj := w.object(id); obj != nil {
+			if i == 0 {
+				w.bind(obj, viewState{}) // index
+			} else {
+				w.bind(obj, view)
+			}
+		}
+	}
+	w.walk(stmt.Body)
+}
+
+// viewValue returns the view state if the values are a single call to a view-returning bulk function, or a single
+// bucket of such a result. The zero value is returned otherwise.
+func (w *walker) viewValue(values []ast.Expr) viewState {
+	if len(values) != 1 {
+		return viewState{}
+	}
+	switch v := unparen(values[0]).(type) {
+	case *ast.CallExpr:
+		fn := bulkFunc(w.pass, v)
+		if fn == nil {
+			return viewState{}
+		} else if _, ok := viewFuncs[fn.Name()]; !ok {
+			return viewState{}
+		}
+		// the declared result type distinguishes buckets from a [][]T instantiation of a single result
+		results := fn.Type().(*types.Signature).Results()
+		outer, _ := results.At(0).Type().(*types.Slice)
+		_, nested := outer.Elem().(*types.Slice)
+		return viewState{funcName: fn.Name(), nested: nested}
+	case *ast.IndexExpr:
+		if outer, ok := w.viewOf(v.X); ok && outer.nested {
+			return viewState{funcName: outer.funcName}
+		}
+	}
+	return viewState{}
+}
+
+// bind records that the variable was assigned a new value, which may be a view from a bulk function.
+func (w *walker) bind(obj types.Object, view viewState) {
+	delete(w.consumed, obj)
+	if view.funcName != "" {
+		w.views[obj] = view
+	} else {
+		delete(w.views, obj)
+	}
+}
+
+func (w *walker) call(call *ast.CallExpr) {
+	// identifiers may be builtins, or bulk functions when the package is dot imported
+	if id, ok := unparen(call.Fun).(*ast.Ident); ok && len(call.Args) > 0 {
+		if b, ok := w.pass.TypesInfo.Uses[id].(*types.Builtin); ok {
+			switch b.Name() {
+			case "append":
+				if fn := w.sliceViewOf(call.Args[0]); fn != "" {
+					w.pass.Reportf(call.Pos(), "append to result of bulk.%s which may be a view of its input%s",
+						fn, copySuggestion(fn))
+				}
+			case "copy":
+				if fn := w.sliceViewOf(call.Args[0]); fn != "" {
+					w.pass.Reportf(call.Pos(), "copy into result of bulk.%s which may be a view of its input%s",
+						fn, copySuggestion(fn))
+				}
+			}
+			return
+		}
+	}
+
+	var name string
+	if fn := bulkFunc(w.pass, call); fn != nil {
+		name = fn.Name()
+	}
+	if !strings.Contains(name, "InPlace") || sameSliceFuncs[name] {
+		return
+	}
+	params := typeutil.Callee(w.pass.TypesInfo, call).Type().(*types.Signature).Params()
+	for i, arg := range call.Args {
+		paramIdx := i
+		if paramIdx >= params.Len() { // variadic arguments
+			paramIdx = params.Len() - 1
+		}
+		// only the inputs which are modified are consumed, not arguments such as indexes
+		if paramName := params.At(paramIdx).Name(); paramName != "slice" && paramName != "slices" {
+			continue
+		}
+		id, ok := unparen(arg).(*ast.Ident)
+		if !ok {
+			continue
+		} else if obj := w.object(id); obj != nil {
+			w.consumed[obj] = consumedState{funcName: name, end: call.End()}
+		}
+	}
+}
+
+func (w *walker) use(id *ast.Ident) {
+	obj := w.pass.TypesInfo.Uses[id]
+	if obj == nil {
+		return
+	}
+	if state, ok := w.consumed[obj]; ok && id.Pos() > state.end {
+		w.pass.Reportf(id.Pos(), "%s is used after being passed to bulk.%s, which may have modified it",
+			id.Name, state.funcName)
+		delete(w.consumed, obj) // report once
+	}
+}
+
+// checkElementWrite reports writes to elements of a possibly aliased result, including fields of struct elements.
+func (w *walker) checkElementWrite(expr ast.Expr) {
+	for {
+		switch e := unparen(expr).(type) {
+		case *ast.IndexExpr:
+			if fn := w.sliceViewOf(e.X); fn != "" {
+				w.pass.Reportf(e.Pos(), "element write to result of bulk.%s which may be a view of its input%s",
+					fn, copySuggestion(fn))
+				return
+			}
+			expr = e.X
+		case *ast.SelectorExpr:
+			if _, ptr := w.pass.TypesInfo.TypeOf(e.X).Underlying().(*types.Pointer); ptr {
+				return // write is through a pointer, shared regardless of the slice memory
+			}
+			expr = e.X
+		default:
+			return
+		}
+	}
+}
+
+// viewOf returns the view state of a variable referenced by the expression.
+func (w *walker) viewOf(expr ast.Expr) (viewState, bool) {
+	id, ok := unparen(expr).(*ast.Ident)
+	if !ok {
+		return viewState{}, false
+	}
+	view, ok := w.views[w.pass.TypesInfo.Uses[id]]
+	return view, ok
+}
+
+// sliceViewOf returns the view-returning bulk function if the expression is a slice which may be a view, either a
+// variable holding such a result or a bucket indexed from one. An empty string is returned otherwise.
+func (w *walker) sliceViewOf(expr ast.Expr) string {
+	nested := false
+	if idx, ok := unparen(expr).(*ast.IndexExpr); ok {
+		expr, nested = idx.X, true
+	}
+	if view, ok := w.viewOf(expr); ok && view.nested == nested {
+		return view.funcName
+	}
+	return ""
+}
+
+// copySuggestion returns a message suffix naming the copying counterpart of a view-returning function.
+func copySuggestion(viewFunc string) string {
+	if copyFunc := viewFuncs[viewFunc]; copyFunc != "" {
+		return "; use bulk." + copyFunc
+	}
+	return ""
+}
+
+func (w *walker) object(id *ast.Ident) types.Object {
+	if obj := w.pass.TypesInfo.Defs[id]; obj != nil {
+		return obj
+	}
+	return w.pass.TypesInfo.Uses[id]
+}
+
+// bulkFunc returns the bulk package function invoked by the call, or nil.
+func bulkFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != bulkPkgPath {
+		return nil
+	} else if sig, ok := fn.Type().(*types.Signature); ok && sig.Recv() != nil {
+		return nil // methods are not tracked
+	}
+	return fn.Origin()
+}
+
+func unparen(expr ast.Expr) ast.Expr {
+	for {
+		p, ok := expr.(*ast.ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = p.X
+	}
+}
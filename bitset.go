@@ -0,0 +1,542 @@
+package bulk
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+// Bitset is a dense set of non-negative integers, using a single bit per value up to the largest value added.
+// It is far smaller and faster than a map based set when values are densely packed, such as sequential IDs. For
+// sparse or very large values use RoaringSet instead. The zero value is an empty set ready for use.
+type Bitset[T integer] struct {
+	words []uint64
+	count int
+}
+
+// SliceToBitset returns a Bitset containing the values from all provided slices.
+// Panics if any value is negative.
+func SliceToBitset[T integer](slices ...[]T) *Bitset[T] {
+	var maxVal T
+	for _, slice := range slices {
+		for _, v := range slice {
+			if v < 0 {
+				panic(fmt.Sprintf("bulk: negative bitset value %d", v))
+			} else if v > maxVal {
+				maxVal = v
+			}
+		}
+	}
+	b := &Bitset[T]{}
+	if sliceLen(slices) > 0 {
+		b.words = make([]uint64, uint64(maxVal)/64+1)
+	}
+	for _, slice := range slices {
+		for _, v := range slice {
+			b.Add(v)
+		}
+	}
+	return b
+}
+
+// Add inserts the value, returning true if it was not already present. Panics if the value is negative.
+func (b *Bitset[T]) Add(val T) bool {
+	if val < 0 {
+		panic(fmt.Sprintf("bulk: negative bitset value %d", val))
+	}
+	wordIdx := uint64(val) / 64
+	if wordIdx >= uint64(len(b.words)) {
+		words := make([]uint64, wordIdx+1, 2*wordIdx+1)
+		copy(words, b.words)
+		b.words = words
+	}
+	mask := uint64(1) << (uint64(val) % 64)
+	if b.words[wordIdx]&mask != 0 {
+		return false
+	}
+	b.words[wordIdx] |= mask
+	b.count++
+	return true
+}
+
+// Remove deletes the value, returning true if it was present.
+func (b *Bitset[T]) Remove(val T) bool {
+	if !b.Contains(val) {
+		return false
+	}
+	b.words[uint64(val)/64] &^= 1 << (uint64(val) % 64)
+	b.count--
+	return true
+}
+
+// Contains returns true if the value is in the set.
+func (b *Bitset[T]) Contains(val T) bool {
+	if val < 0 || uint64(val)/64 >= uint64(len(b.words)) {
+		return false
+	}
+	return b.words[uint64(val)/64]&(1<<(uint64(val)%64)) != 0
+}
+
+// Count returns the number of values in the set.
+func (b *Bitset[T]) Count() int {
+	return b.count
+}
+
+// Union returns a new Bitset containing the values present in either set.
+func (b *Bitset[T]) Union(other *Bitset[T]) *Bitset[T] {
+	long, short := b.words, other.words
+	if len(short) > len(long) {
+		long, short = short, long
+	}
+	result := &Bitset[T]{words: make([]uint64, len(long))}
+	copy(result.words, long)
+	for i, w := range short {
+		result.words[i] |= w
+	}
+	result.count = bitsetCount(result.words)
+	return result
+}
+
+// Intersect returns a new Bitset containing the values present in both sets.
+func (b *Bitset[T]) Intersect(other *Bitset[T]) *Bitset[T] {
+	n := len(b.words)
+	if len(other.words) < n {
+		n = len(other.words)
+	}
+	words := make([]uint64, n)
+	for i := range words {
+		words[i] = b.words[i] & other.words[i]
+	}
+	return newBitsetTrimmed[T](words)
+}
+
+// Difference returns a new Bitset containing the values present in this set but not in the other.
+func (b *Bitset[T]) Difference(other *Bitset[T]) *Bitset[T] {
+	words := make([]uint64, len(b.words))
+	copy(words, b.words)
+	for i := 0; i < len(words) && i < len(other.words); i++ {
+		words[i] &^= other.words[i]
+	}
+	return newBitsetTrimmed[T](words)
+}
+
+// ToSlice returns the values in ascending order, allocated to exactly the number of values, or nil if empty.
+func (b *Bitset[T]) ToSlice() []T {
+	if b.count == 0 {
+		return nil
+	}
+	result := make([]T, 0, b.count)
+	for wordIdx, w := range b.words {
+		for w != 0 {
+			result = append(result, T(uint64(wordIdx)*64+uint64(bits.TrailingZeros64(w))))
+			w &= w - 1 // clear lowest set bit
+		}
+	}
+	return result
+}
+
+// newBitsetTrimmed returns a Bitset over the words with trailing empty words removed.
+func newBitsetTrimmed[T integer](words []uint64) *Bitset[T] {
+	for len(words) > 0 && words[len(words)-1] == 0 {
+		words = words[:len(words)-1]
+	}
+	return &Bitset[T]{words: words, count: bitsetCount(words)}
+}
+
+func bitsetCount(words []uint64) int {
+	var count int
+	for _, w := range words {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// roaringArrayMax is the cardinality above which a RoaringSet container switches from a sorted array to a bitmap.
+const roaringArrayMax = 4096
+
+// roaringContainer holds the low 16 bits of values sharing the same high bits, either as a sorted array when sparse
+// or as a 65536 bit bitmap when dense.
+type roaringContainer struct {
+	array  []uint16
+	bitmap []uint64 // non-nil when the container is a bitmap
+	count  int
+}
+
+// RoaringSet is a compressed set of integers in the style of Roaring bitmaps. Values are grouped by their high bits
+// into containers of 65536 values, each stored as a sorted array when sparse or a bitmap when dense. This provides
+// memory use close to a sorted slice for sparse values, and close to a Bitset for dense values, without requiring
+// memory proportional to the largest value. Negative values are supported. The zero value is an empty set.
+type RoaringSet[T integer] struct {
+	keys       []uint64 // sorted high bits of the containers
+	containers []*roaringContainer
+	count      int
+}
+
+// roaringKey maps the value onto uint64 preserving order, so signed values are supported.
+func roaringKey[T integer](val T) uint64 {
+	return uint64(val) ^ radixSignMask[T]()
+}
+
+// SliceToRoaringSet returns a RoaringSet containing the values from all provided slices.
+func SliceToRoaringSet[T integer](slices ...[]T) *RoaringSet[T] {
+	keys := make([]uint64, 0, sliceLen(slices))
+	for _, slice := range slices {
+		for _, v := range slice {
+			keys = append(keys, roaringKey(v))
+		}
+	}
+	radixSort(keys)
+
+	r := &RoaringSet[T]{}
+	for i, k := range keys {
+		if i > 0 && keys[i-1] == k {
+			continue // duplicate
+		}
+		high := k >> 16
+		if len(r.keys) == 0 || r.keys[len(r.keys)-1] != high {
+			r.keys = append(r.keys, high)
+			r.containers = append(r.containers, &roaringContainer{})
+		}
+		c := r.containers[len(r.containers)-1]
+		if c.bitmap == nil {
+			c.array = append(c.array, uint16(k))
+			if len(c.array) > roaringArrayMax {
+				c.toBitmap()
+			}
+		} else {
+			c.bitmap[uint16(k)/64] |= 1 << (uint16(k) % 64)
+		}
+		c.count++
+		r.count++
+	}
+	for _, c := range r.containers {
+		if c.bitmap == nil {
+			c.array = sliceCopy(c.array) // release excess capacity from append growth
+		}
+	}
+	return r
+}
+
+func (r *RoaringSet[T]) container(high uint64) (int, bool) {
+	idx := sort.Search(len(r.keys), func(i int) bool { return r.keys[i] >= high })
+	return idx, idx < len(r.keys) && r.keys[idx] == high
+}
+
+// Add inserts the value, returning true if it was not already present.
+func (r *RoaringSet[T]) Add(val T) bool {
+	k := roaringKey(val)
+	idx, found := r.container(k >> 16)
+	if !found {
+		r.keys = append(r.keys, 0)
+		copy(r.keys[idx+1:], r.keys[idx:])
+		r.keys[idx] = k >> 16
+		r.containers = append(r.containers, nil)
+		copy(r.containers[idx+1:], r.containers[idx:])
+		r.containers[idx] = &roaringContainer{}
+	}
+	if r.containers[idx].add(uint16(k)) {
+		r.count++
+		return true
+	}
+	return false
+}
+
+// Remove deletes the value, returning true if it was present.
+func (r *RoaringSet[T]) Remove(val T) bool {
+	k := roaringKey(val)
+	idx, found := r.container(k >> 16)
+	if !found || !r.containers[idx].remove(uint16(k)) {
+		return false
+	}
+	r.count--
+	if r.containers[idx].count == 0 {
+		r.keys = append(r.keys[:idx], r.keys[idx+1:]...)
+		r.containers = append(r.containers[:idx], r.containers[idx+1:]...)
+	}
+	return true
+}
+
+// Contains returns true if the value is in the set.
+func (r *RoaringSet[T]) Contains(val T) bool {
+	k := roaringKey(val)
+	idx, found := r.container(k >> 16)
+	return found && r.containers[idx].contains(uint16(k))
+}
+
+// Count returns the number of values in the set.
+func (r *RoaringSet[T]) Count() int {
+	return r.count
+}
+
+// Union returns a new RoaringSet containing the values present in either set.
+func (r *RoaringSet[T]) Union(other *RoaringSet[T]) *RoaringSet[T] {
+	result := &RoaringSet[T]{}
+	var i, j int
+	for i < len(r.keys) || j < len(other.keys) {
+		switch {
+		case j >= len(other.keys) || (i < len(r.keys) && r.keys[i] < other.keys[j]):
+			result.appendContainer(r.keys[i], r.containers[i].clone())
+			i++
+		case i >= len(r.keys) || other.keys[j] < r.keys[i]:
+			result.appendContainer(other.keys[j], other.containers[j].clone())
+			j++
+		default:
+			result.appendContainer(r.keys[i], r.containers[i].union(other.containers[j]))
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// Intersect returns a new RoaringSet containing the values present in both sets.
+func (r *RoaringSet[T]) Intersect(other *RoaringSet[T]) *RoaringSet[T] {
+	result := &RoaringSet[T]{}
+	for i, j := 0, 0; i < len(r.keys) && j < len(other.keys); {
+		switch {
+		case r.keys[i] < other.keys[j]:
+			i++
+		case other.keys[j] < r.keys[i]:
+			j++
+		default:
+			result.appendContainer(r.keys[i], r.containers[i].intersect(other.containers[j]))
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// Difference returns a new RoaringSet containing the values present in this set but not in the other.
+func (r *RoaringSet[T]) Difference(other *RoaringSet[T]) *RoaringSet[T] {
+	result := &RoaringSet[T]{}
+	j := 0
+	for i, high := range r.keys {
+		for j < len(other.keys) && other.keys[j] < high {
+			j++
+		}
+		if j < len(other.keys) && other.keys[j] == high {
+			result.appendContainer(high, r.containers[i].difference(other.containers[j]))
+		} else {
+			result.appendContainer(high, r.containers[i].clone())
+		}
+	}
+	return result
+}
+
+// ToSlice returns the values in ascending order, allocated to exactly the number of values, or nil if empty.
+func (r *RoaringSet[T]) ToSlice() []T {
+	if r.count == 0 {
+		return nil
+	}
+	signMask := radixSignMask[T]()
+	result := make([]T, 0, r.count)
+	for i, c := range r.containers {
+		base := r.keys[i] << 16
+		if c.bitmap == nil {
+			for _, low := range c.array {
+				result = append(result, T((base|uint64(low))^signMask))
+			}
+			continue
+		}
+		for wordIdx, w := range c.bitmap {
+			for w != 0 {
+				low := uint64(wordIdx)*64 + uint64(bits.TrailingZeros64(w))
+				result = append(result, T((base|low)^signMask))
+				w &= w - 1 // clear lowest set bit
+			}
+		}
+	}
+	return result
+}
+
+// appendContainer adds a container with a key greater than all existing keys, skipping empty containers.
+func (r *RoaringSet[T]) appendContainer(high uint64, c *roaringContainer) {
+	if c.count == 0 {
+		return
+	}
+	r.keys = append(r.keys, high)
+	r.containers = append(r.containers, c)
+	r.count += c.count
+}
+
+func (c *roaringContainer) contains(low uint16) bool {
+	if c.bitmap != nil {
+		return c.bitmap[low/64]&(1<<(low%64)) != 0
+	}
+	idx := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= low })
+	return idx < len(c.array) && c.array[idx] == low
+}
+
+func (c *roaringContainer) add(low uint16) bool {
+	if c.bitmap != nil {
+		mask := uint64(1) << (low % 64)
+		if c.bitmap[low/64]&mask != 0 {
+			return false
+		}
+		c.bitmap[low/64] |= mask
+		c.count++
+		return true
+	}
+	idx := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= low })
+	if idx < len(c.array) && c.array[idx] == low {
+		return false
+	}
+	c.array = append(c.array, 0)
+	copy(c.array[idx+1:], c.array[idx:])
+	c.array[idx] = low
+	c.count++
+	if c.count > roaringArrayMax {
+		c.toBitmap()
+	}
+	return true
+}
+
+func (c *roaringContainer) remove(low uint16) bool {
+	if c.bitmap != nil {
+		mask := uint64(1) << (low % 64)
+		if c.bitmap[low/64]&mask == 0 {
+			return false
+		}
+		c.bitmap[low/64] &^= mask
+		c.count--
+		if c.count <= roaringArrayMax {
+			c.toArray()
+		}
+		return true
+	}
+	idx := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= low })
+	if idx == len(c.array) || c.array[idx] != low {
+		return false
+	}
+	c.array = append(c.array[:idx], c.array[idx+1:]...)
+	c.count--
+	return true
+}
+
+func (c *roaringContainer) toBitmap() {
+	c.bitmap = make([]uint64, 1024)
+	for _, low := range c.array {
+		c.bitmap[low/64] |= 1 << (low % 64)
+	}
+	c.array = nil
+}
+
+func (c *roaringContainer) toArray() {
+	array := make([]uint16, 0, c.count)
+	for wordIdx, w := range c.bitmap {
+		for w != 0 {
+			array = append(array, uint16(wordIdx*64+bits.TrailingZeros64(w)))
+			w &= w - 1 // clear lowest set bit
+		}
+	}
+	c.array = array
+	c.bitmap = nil
+}
+
+// normalize selects the container representation based on the cardinality.
+func (c *roaringContainer) normalize() *roaringContainer {
+	if c.bitmap != nil && c.count <= roaringArrayMax {
+		c.toArray()
+	} else if c.bitmap == nil && c.count > roaringArrayMax {
+		c.toBitmap()
+	}
+	return c
+}
+
+func (c *roaringContainer) clone() *roaringContainer {
+	return &roaringContainer{array: sliceCopy(c.array), bitmap: sliceCopy(c.bitmap), count: c.count}
+}
+
+// bitmapCopy returns the container values as a newly allocated bitmap.
+func (c *roaringContainer) bitmapCopy() []uint64 {
+	if c.bitmap != nil {
+		return sliceCopy(c.bitmap)
+	}
+	bitmap := make([]uint64, 1024)
+	for _, low := range c.array {
+		bitmap[low/64] |= 1 << (low % 64)
+	}
+	return bitmap
+}
+
+func (c *roaringContainer) union(other *roaringContainer) *roaringContainer {
+	if c.bitmap == nil && other.bitmap == nil && c.count+other.count <= roaringArrayMax {
+		array := make([]uint16, 0, c.count+other.count)
+		var i, j int
+		for i < len(c.array) && j < len(other.array) {
+			switch {
+			case c.array[i] < other.array[j]:
+				array = append(array, c.array[i])
+				i++
+			case other.array[j] < c.array[i]:
+				array = append(array, other.array[j])
+				j++
+			default:
+				array = append(array, c.array[i])
+				i++
+				j++
+			}
+		}
+		array = append(array, c.array[i:]...)
+		array = append(array, other.array[j:]...)
+		return &roaringContainer{array: array, count: len(array)}
+	}
+
+	bitmap := c.bitmapCopy()
+	if other.bitmap != nil {
+		for i, w := range other.bitmap {
+			bitmap[i] |= w
+		}
+	} else {
+		for _, low := range other.array {
+			bitmap[low/64] |= 1 << (low % 64)
+		}
+	}
+	return (&roaringContainer{bitmap: bitmap, count: bitsetCount(bitmap)}).normalize()
+}
+
+func (c *roaringContainer) intersect(other *roaringContainer) *roaringContainer {
+	if c.bitmap != nil && other.bitmap != nil {
+		bitmap := make([]uint64, 1024)
+		for i := range bitmap {
+			bitmap[i] = c.bitmap[i] & other.bitmap[i]
+		}
+		return (&roaringContainer{bitmap: bitmap, count: bitsetCount(bitmap)}).normalize()
+	}
+	small, large := c, other
+	if small.bitmap != nil {
+		small, large = large, small
+	}
+	var array []uint16
+	for _, low := range small.array {
+		if large.contains(low) {
+			array = append(array, low)
+		}
+	}
+	return &roaringContainer{array: sliceCopy(array), count: len(array)}
+}
+
+func (c *roaringContainer) difference(other *roaringContainer) *roaringContainer {
+	if c.bitmap == nil {
+		var array []uint16
+		for _, low := range c.array {
+			if !other.contains(low) {
+				array = append(array, low)
+			}
+		}
+		return &roaringContainer{array: sliceCopy(array), count: len(array)}
+	}
+
+	bitmap := sliceCopy(c.bitmap)
+	if other.bitmap != nil {
+		for i, w := range other.bitmap {
+			bitmap[i] &^= w
+		}
+	} else {
+		for _, low := range other.array {
+			bitmap[low/64] &^= 1 << (low % 64)
+		}
+	}
+	return (&roaringContainer{bitmap: bitmap, count: bitsetCount(bitmap)}).normalize()
+}
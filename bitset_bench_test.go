@@ -0,0 +1,34 @@
+package bulk
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func BenchmarkIntegerSetIntersect(b *testing.B) {
+	rng := rand.New(rand.NewSource(42))
+	a := make([]uint32, 200_000)
+	for i := range a {
+		a[i] = uint32(rng.Intn(1_000_000))
+	}
+	c := make([]uint32, 200_000)
+	for i := range c {
+		c[i] = uint32(rng.Intn(1_000_000))
+	}
+
+	b.Run("SliceIntersect", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = SliceIntersect(a, c)
+		}
+	})
+	b.Run("Bitset", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = SliceToBitset(a).Intersect(SliceToBitset(c)).ToSlice()
+		}
+	})
+	b.Run("RoaringSet", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = SliceToRoaringSet(a).Intersect(SliceToRoaringSet(c)).ToSlice()
+		}
+	})
+}
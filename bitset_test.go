@@ -0,0 +1,196 @@
+package bulk
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// integerSet is the shared interface of Bitset and RoaringSet, used to verify both against a map based model.
+type integerSet[T integer, S any] interface {
+	Add(val T) bool
+	Remove(val T) bool
+	Contains(val T) bool
+	Count() int
+	ToSlice() []T
+	Union(other S) S
+	Intersect(other S) S
+	Difference(other S) S
+}
+
+func sortedSetValues[T integer](m map[T]struct{}) []T {
+	if len(m) == 0 {
+		return nil
+	}
+	values := MapKeysSlice(m)
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	return values
+}
+
+func assertIntegerSetOps[T integer, S integerSet[T, S]](t *testing.T, rng *rand.Rand, build func(slices ...[]T) S, gen func() T) {
+	t.Helper()
+
+	for round := 0; round < 10; round++ {
+		a := make([]T, rng.Intn(10_000))
+		for i := range a {
+			a[i] = gen()
+		}
+		b := make([]T, rng.Intn(10_000))
+		for i := range b {
+			b[i] = gen()
+		}
+		aSet := SliceToSet(a)
+		setA, setB := build(a), build(b)
+
+		require.Equal(t, len(aSet), setA.Count())
+		require.Equal(t, sortedSetValues(aSet), setA.ToSlice())
+
+		union := SliceToSet(a, b)
+		assert.Equal(t, sortedSetValues(union), setA.Union(setB).ToSlice())
+		assert.Equal(t, len(union), setA.Union(setB).Count())
+
+		intersect := SliceToSet(SliceIntersect(a, b))
+		assert.Equal(t, sortedSetValues(intersect), setA.Intersect(setB).ToSlice())
+		assert.Equal(t, len(intersect), setA.Intersect(setB).Count())
+
+		difference := SliceToSet(SliceDifference(a, b))
+		assert.Equal(t, sortedSetValues(difference), setA.Difference(setB).ToSlice())
+		assert.Equal(t, len(difference), setA.Difference(setB).Count())
+		assert.Equal(t, len(aSet), setA.Count()) // operations do not modify their inputs
+
+		for i := 0; i < 5000; i++ {
+			v := gen()
+			_, exists := aSet[v]
+			require.Equal(t, exists, setA.Contains(v))
+			if i%2 == 0 {
+				require.Equal(t, exists, setA.Remove(v))
+				delete(aSet, v)
+			} else {
+				require.Equal(t, !exists, setA.Add(v))
+				aSet[v] = struct{}{}
+			}
+		}
+		require.Equal(t, len(aSet), setA.Count())
+		require.Equal(t, sortedSetValues(aSet), setA.ToSlice())
+	}
+}
+
+func TestBitset(t *testing.T) {
+	t.Parallel()
+
+	t.Run("random", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(42))
+		assertIntegerSetOps[uint32, *Bitset[uint32]](t, rng, SliceToBitset[uint32],
+			func() uint32 { return uint32(rng.Intn(50_000)) })
+	})
+	t.Run("zero_value", func(t *testing.T) {
+		var b Bitset[int]
+
+		assert.False(t, b.Contains(5))
+		assert.False(t, b.Contains(-1))
+		assert.False(t, b.Remove(5))
+		assert.Nil(t, b.ToSlice())
+		assert.True(t, b.Add(130))
+		assert.True(t, b.Add(2))
+		assert.Equal(t, []int{2, 130}, b.ToSlice())
+	})
+	t.Run("from_slices", func(t *testing.T) {
+		b := SliceToBitset([]int{5, 1, 64}, nil, []int{1, 3})
+
+		assert.Equal(t, 4, b.Count())
+		assert.Equal(t, []int{1, 3, 5, 64}, b.ToSlice())
+		assert.Equal(t, 0, SliceToBitset[int]().Count())
+	})
+	t.Run("trims", func(t *testing.T) {
+		a := SliceToBitset([]int{1, 1000})
+		b := SliceToBitset([]int{1000})
+
+		assert.Len(t, a.Difference(b).words, 1)
+		assert.Empty(t, a.Intersect(SliceToBitset([]int{2})).words)
+	})
+	t.Run("negative_panics", func(t *testing.T) {
+		assert.Panics(t, func() { SliceToBitset([]int{1, -1}) })
+		assert.Panics(t, func() { (&Bitset[int8]{}).Add(-1) })
+	})
+}
+
+func TestRoaringSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		gen  func(rng *rand.Rand) int64
+	}{
+		{
+			name: "sparse",
+			gen:  func(rng *rand.Rand) int64 { return rng.Int63n(1 << 40) },
+		},
+		{
+			name: "dense",
+			gen:  func(rng *rand.Rand) int64 { return rng.Int63n(1 << 17) },
+		},
+		{
+			name: "signed",
+			gen:  func(rng *rand.Rand) int64 { return rng.Int63n(1<<18) - 1<<17 },
+		},
+		{
+			name: "mixed",
+			gen: func(rng *rand.Rand) int64 {
+				if rng.Intn(2) == 0 {
+					return rng.Int63n(1 << 16) // a single container near the bitmap threshold
+				}
+				return rng.Int63()
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			rng := rand.New(rand.NewSource(int64(i)))
+			assertIntegerSetOps[int64, *RoaringSet[int64]](t, rng, SliceToRoaringSet[int64],
+				func() int64 { return tt.gen(rng) })
+		})
+	}
+
+	t.Run("container_conversion", func(t *testing.T) {
+		values := make([]uint32, roaringArrayMax+1)
+		for i := range values {
+			values[i] = uint32(i * 2)
+		}
+		r := SliceToRoaringSet(values)
+		require.Len(t, r.containers, 1)
+		assert.NotNil(t, r.containers[0].bitmap)
+
+		assert.True(t, r.Remove(0))
+		assert.Nil(t, r.containers[0].bitmap)
+		assert.Len(t, r.containers[0].array, roaringArrayMax)
+
+		assert.True(t, r.Add(1))
+		assert.NotNil(t, r.containers[0].bitmap)
+		assert.Equal(t, roaringArrayMax+1, r.Count())
+	})
+	t.Run("extremes", func(t *testing.T) {
+		r := SliceToRoaringSet([]int8{math.MaxInt8, math.MinInt8, 0, -1})
+
+		assert.Equal(t, []int8{math.MinInt8, -1, 0, math.MaxInt8}, r.ToSlice())
+		assert.True(t, r.Contains(-1))
+		assert.False(t, r.Contains(1))
+	})
+	t.Run("zero_value", func(t *testing.T) {
+		var r RoaringSet[uint64]
+
+		assert.Nil(t, r.ToSlice())
+		assert.False(t, r.Remove(1))
+		assert.True(t, r.Add(math.MaxUint64))
+		assert.True(t, r.Add(1))
+		assert.False(t, r.Add(1))
+		assert.Equal(t, []uint64{1, math.MaxUint64}, r.ToSlice())
+		assert.True(t, r.Remove(math.MaxUint64))
+		assert.Len(t, r.containers, 1)
+	})
+}
@@ -0,0 +1,174 @@
+package bulk
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// bloomFormatVersion identifies the serialized layout produced by BloomFilter.MarshalBinary.
+const bloomFormatVersion = 1
+
+// bloomHeaderSize is the serialized size of the version, hash count, bit count, and added count.
+const bloomHeaderSize = 1 + 4 + 8 + 8
+
+// BloomFilter is a probabilistic set which reports whether a key was possibly added, using a fixed amount of memory.
+// A BloomFilter never reports a false negative, while false positives occur at the rate configured at construction.
+// Keys are hashed consistently across processes (see SliceShardBy), so filters can be persisted with MarshalBinary
+// and restored with UnmarshalBinary in a later run.
+type BloomFilter[K shardKey] struct {
+	words  []uint64
+	bits   uint64
+	hashes uint32
+	count  uint64
+}
+
+// NewBloomFilter returns an empty BloomFilter sized to hold the expected number of keys with the provided false
+// positive rate. Panics if falsePositiveRate is outside (0, 1).
+func NewBloomFilter[K shardKey](expected int, falsePositiveRate float64) *BloomFilter[K] {
+	if !(falsePositiveRate > 0 && falsePositiveRate < 1) {
+		panic("bulk: BloomFilter false positive rate must be within (0, 1)")
+	}
+	if expected < 1 {
+		expected = 1
+	}
+	bitCount := uint64(math.Ceil(-float64(expected) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	if bitCount < 64 {
+		bitCount = 64
+	}
+	hashes := uint32(math.Round(float64(bitCount) / float64(expected) * math.Ln2))
+	if hashes < 1 {
+		hashes = 1
+	}
+	return &BloomFilter[K]{words: make([]uint64, (bitCount+63)/64), bits: bitCount, hashes: hashes}
+}
+
+// SliceToBloomFilter returns a BloomFilter containing every element of the slices, sized for their combined length
+// with the provided false positive rate.
+func SliceToBloomFilter[K shardKey](falsePositiveRate float64, slices ...[]K) *BloomFilter[K] {
+	f := NewBloomFilter[K](sliceLen(slices), falsePositiveRate)
+	f.AddSlices(slices...)
+	return f
+}
+
+// bloomHashes returns the two base hashes combined to derive each bit position (Kirsch-Mitzenmacher).
+func bloomHashes[K shardKey](key K) (uint64, uint64) {
+	h1 := shardHash(key)
+	return h1, mixHash(h1+0x9e3779b97f4a7c15) | 1
+}
+
+// Add records the key.
+func (f *BloomFilter[K]) Add(key K) {
+	h1, h2 := bloomHashes(key)
+	for i := uint32(0); i < f.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % f.bits
+		f.words[bit/64] |= 1 << (bit % 64)
+	}
+	f.count++
+}
+
+// AddSlices records every element of the slices.
+func (f *BloomFilter[K]) AddSlices(slices ...[]K) {
+	for _, slice := range slices {
+		for _, key := range slice {
+			f.Add(key)
+		}
+	}
+}
+
+// Contains returns false if the key was definitely never added, or true if it was possibly added.
+func (f *BloomFilter[K]) Contains(key K) bool {
+	h1, h2 := bloomHashes(key)
+	for i := uint32(0); i < f.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % f.bits
+		if f.words[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns the number of keys added, including duplicates.
+func (f *BloomFilter[K]) Count() uint64 {
+	return f.count
+}
+
+// Merge adds all keys from the other filter into this one. Panics if the filters were created with different sizes.
+func (f *BloomFilter[K]) Merge(other *BloomFilter[K]) {
+	if f.bits != other.bits || f.hashes != other.hashes {
+		panic("bulk: BloomFilter size mismatch")
+	}
+	for i, w := range other.words {
+		f.words[i] |= w
+	}
+	f.count += other.count
+}
+
+// MarshalBinary encodes the filter into a versioned, little-endian byte representation.
+func (f *BloomFilter[K]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, bloomHeaderSize+8*len(f.words))
+	data[0] = bloomFormatVersion
+	binary.LittleEndian.PutUint32(data[1:], f.hashes)
+	binary.LittleEndian.PutUint64(data[5:], f.bits)
+	binary.LittleEndian.PutUint64(data[13:], f.count)
+	for i, w := range f.words {
+		binary.LittleEndian.PutUint64(data[bloomHeaderSize+8*i:], w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary replaces the filter with one decoded from data produced by MarshalBinary.
+func (f *BloomFilter[K]) UnmarshalBinary(data []byte) error {
+	if len(data) < bloomHeaderSize {
+		return errors.New("bulk: BloomFilter data too short")
+	} else if data[0] != bloomFormatVersion {
+		return errors.New("bulk: unsupported BloomFilter format version")
+	}
+	hashes := binary.LittleEndian.Uint32(data[1:])
+	bitCount := binary.LittleEndian.Uint64(data[5:])
+	wordCount := uint64(len(data)-bloomHeaderSize) / 8
+	// compare without rounding bitCount up, which could overflow for a corrupt header
+	if hashes == 0 || bitCount == 0 || uint64(len(data)-bloomHeaderSize)%8 != 0 ||
+		bitCount > 64*wordCount || bitCount <= 64*(wordCount-1) ||
+		uint64(hashes) > bitCount { // NewBloomFilter never exceeds one hash per bit, bounding the work per key
+		return errors.New("bulk: invalid BloomFilter data")
+	}
+	words := make([]uint64, wordCount)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[bloomHeaderSize+8*i:])
+	}
+	*f = BloomFilter[K]{words: words, bits: bitCount, hashes: hashes, count: binary.LittleEndian.Uint64(data[13:])}
+	return nil
+}
+
+// SliceDifferenceApprox returns elements of slice a which are not in the filter, preserving order and removing
+// duplicates like SliceDifference. Elements contained in the filter are never returned, while an element not in the
+// filter is omitted at the filter's false positive rate. Useful when the excluded set is too large to hold in a map.
+func SliceDifferenceApprox[T shardKey](a []T, exclude *BloomFilter[T]) []T {
+	return sliceFilterApprox(a, exclude, false)
+}
+
+// SliceIntersectApprox returns elements of slice a which are possibly in the filter, preserving order and removing
+// duplicates like SliceIntersect. Every element contained in the filter is returned, while elements not in the
+// filter are included at the filter's false positive rate.
+func SliceIntersectApprox[T shardKey](a []T, include *BloomFilter[T]) []T {
+	return sliceFilterApprox(a, include, true)
+}
+
+func sliceFilterApprox[T shardKey](a []T, filter *BloomFilter[T], contained bool) []T {
+	var result []T
+	var seen map[T]struct{}
+	for aIdx, val := range a {
+		if filter.Contains(val) == contained {
+			if _, duplicate := seen[val]; !duplicate {
+				if result == nil { // allocate based on potential remaining
+					seen = make(map[T]struct{}, len(a)-aIdx)
+					result = make([]T, 0, capGuess(len(a)-aIdx))
+				}
+				seen[val] = struct{}{}
+				result = append(result, val)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+package bulk
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	t.Parallel()
+
+	for _, rate := range []float64{0.1, 0.01, 0.001} {
+		t.Run("rate_"+strconv.FormatFloat(rate, 'f', -1, 64), func(t *testing.T) {
+			const n = 20_000
+			f := NewBloomFilter[uint64](n, rate)
+			for i := uint64(0); i < n; i++ {
+				f.Add(i)
+			}
+
+			for i := uint64(0); i < n; i++ {
+				require.True(t, f.Contains(i)) // no false negatives
+			}
+			var falsePositives int
+			const trials = 100_000
+			for i := uint64(0); i < trials; i++ {
+				if f.Contains(n + i) {
+					falsePositives++
+				}
+			}
+			assert.Less(t, float64(falsePositives)/trials, 1.5*rate)
+			assert.Equal(t, uint64(n), f.Count())
+		})
+	}
+
+	t.Run("slice_to_bloom_filter", func(t *testing.T) {
+		f := SliceToBloomFilter(0.01, []string{"a", "b"}, []string{"c"})
+
+		assert.True(t, f.Contains("a"))
+		assert.True(t, f.Contains("c"))
+		assert.False(t, f.Contains("zz"))
+		assert.Equal(t, uint64(3), f.Count())
+	})
+	t.Run("empty", func(t *testing.T) {
+		f := SliceToBloomFilter[int](0.01)
+
+		assert.False(t, f.Contains(1))
+		assert.Equal(t, uint64(0), f.Count())
+	})
+	t.Run("merge", func(t *testing.T) {
+		a := NewBloomFilter[int](100, 0.01)
+		b := NewBloomFilter[int](100, 0.01)
+		a.AddSlices([]int{1, 2})
+		b.AddSlices([]int{3})
+		a.Merge(b)
+
+		assert.True(t, a.Contains(1))
+		assert.True(t, a.Contains(3))
+		assert.Equal(t, uint64(3), a.Count())
+		assert.Panics(t, func() { a.Merge(NewBloomFilter[int](1000, 0.01)) })
+	})
+	t.Run("panics", func(t *testing.T) {
+		assert.Panics(t, func() { NewBloomFilter[int](10, 0) })
+		assert.Panics(t, func() { NewBloomFilter[int](10, 1) })
+	})
+}
+
+func TestBloomFilterMarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round_trip", func(t *testing.T) {
+		f := SliceToBloomFilter(0.01, []string{"alpha", "beta", "gamma"})
+		data, err := f.MarshalBinary()
+		require.NoError(t, err)
+
+		var restored BloomFilter[string]
+		require.NoError(t, restored.UnmarshalBinary(data))
+		assert.Equal(t, f, &restored)
+		assert.True(t, restored.Contains("beta"))
+		assert.False(t, restored.Contains("delta"))
+	})
+	t.Run("stable_encoding", func(t *testing.T) {
+		// pinned so filters persisted by earlier runs remain readable with the same results
+		f := SliceToBloomFilter(0.1, []int{1, 2, 3})
+		data, err := f.MarshalBinary()
+		require.NoError(t, err)
+
+		assert.Equal(t, "010f00000040000000000000000300000000000000754fd2a5ba824bf2", hex.EncodeToString(data))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		valid, err := SliceToBloomFilter(0.1, []int{1}).MarshalBinary()
+		require.NoError(t, err)
+
+		var f BloomFilter[int]
+		assert.Error(t, f.UnmarshalBinary(nil))
+		assert.Error(t, f.UnmarshalBinary(valid[:len(valid)-1]))
+		badVersion := sliceDup(valid)
+		badVersion[0] = 9
+		assert.Error(t, f.UnmarshalBinary(badVersion))
+		zeroHashes := sliceDup(valid)
+		zeroHashes[1] = 0
+		assert.Error(t, f.UnmarshalBinary(zeroHashes))
+		// a hash count beyond the bit count would make every Add and Contains spin
+		tooManyHashes := sliceDup(valid)
+		binary.LittleEndian.PutUint32(tooManyHashes[1:], math.MaxUint32)
+		assert.Error(t, f.UnmarshalBinary(tooManyHashes))
+		binary.LittleEndian.PutUint32(tooManyHashes[1:], 65) // valid holds 64 bits
+		assert.Error(t, f.UnmarshalBinary(tooManyHashes))
+
+		// a bit count which overflows when rounded up to words must not pass with no words
+		overflow, err := hex.DecodeString("0101000000ffffffffffffffff0000000000000000")
+		require.NoError(t, err)
+		assert.Error(t, f.UnmarshalBinary(overflow))
+		tooManyBits := sliceDup(valid)
+		binary.LittleEndian.PutUint64(tooManyBits[5:], 64*uint64(len(valid)-bloomHeaderSize)/8+1)
+		assert.Error(t, f.UnmarshalBinary(tooManyBits))
+		tooFewBits := sliceDup(valid)
+		binary.LittleEndian.PutUint64(tooFewBits[5:], 1)
+		assert.Error(t, f.UnmarshalBinary(append(tooFewBits, make([]byte, 8)...)))
+	})
+}
+
+func TestSliceDifferenceApprox(t *testing.T) {
+	t.Parallel()
+
+	seen := SliceToBloomFilter(0.001, []int{1, 2, 3, 4, 5})
+
+	tests := []struct {
+		name              string
+		input             []int
+		expectedDiff      []int
+		expectedIntersect []int
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:              "mixed",
+			input:             []int{6, 1, 7, 6, 2, 2},
+			expectedDiff:      []int{6, 7},
+			expectedIntersect: []int{1, 2},
+		},
+		{
+			name:         "all_new",
+			input:        []int{10, 11},
+			expectedDiff: []int{10, 11},
+		},
+		{
+			name:              "all_seen",
+			input:             []int{5, 4},
+			expectedIntersect: []int{5, 4},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedDiff, SliceDifferenceApprox(tt.input, seen))
+			assert.Equal(t, tt.expectedIntersect, SliceIntersectApprox(tt.input, seen))
+		})
+	}
+}
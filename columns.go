@@ -0,0 +1,125 @@
+package bulk
+
+import "sort"
+
+// Column provides positional access to one slice within a set of parallel slices (struct-of-arrays layout).
+// Use SliceColumn to create a Column for a slice.
+type Column interface {
+	// Len returns the number of rows in the column.
+	Len() int
+	// Swap exchanges the rows at indexes i and j.
+	Swap(i, j int)
+	// Move copies the row at index src into index dst.
+	Move(dst, src int)
+	// Truncate shortens the column to n rows.
+	Truncate(n int)
+}
+
+// SliceColumn returns a Column which updates the referenced slice when rows are moved or truncated.
+// The referenced slice header escapes to the heap, so columns over local variables cost one allocation each. Create
+// columns once and reuse them when filtering or sorting repeatedly.
+func SliceColumn[T any](slice *[]T) Column {
+	return sliceColumn[T]{slice: slice}
+}
+
+type sliceColumn[T any] struct {
+	slice *[]T
+}
+
+func (c sliceColumn[T]) Len() int {
+	return len(*c.slice)
+}
+
+func (c sliceColumn[T]) Swap(i, j int) {
+	s := *c.slice
+	s[i], s[j] = s[j], s[i]
+}
+
+func (c sliceColumn[T]) Move(dst, src int) {
+	s := *c.slice
+	s[dst] = s[src]
+}
+
+func (c sliceColumn[T]) Truncate(n int) {
+	*c.slice = (*c.slice)[:n]
+}
+
+// columnsLen returns the shared length of the columns, panicking if the lengths differ.
+func columnsLen(columns []Column) int {
+	if len(columns) == 0 {
+		return 0
+	}
+	n := columns[0].Len()
+	for _, c := range columns[1:] {
+		if c.Len() != n {
+			panic("bulk: parallel columns have different lengths")
+		}
+	}
+	return n
+}
+
+// SliceColumnsFilterInPlace retains the rows that pass the predicate across all parallel columns, preserving order.
+// The predicate receives the original row index, and is invoked in ascending order, so it may read the row from the
+// original slices. Columns are compacted in a single pass and truncated to the retained rows. The filter itself does
+// not allocate, see SliceColumn for the cost of creating the columns.
+// Returns the number of retained rows. Panics if the columns have different lengths.
+func SliceColumnsFilterInPlace(predicate func(row int) bool, columns ...Column) int {
+	rows := columnsLen(columns)
+	var n int
+	for i := 0; i < rows; i++ {
+		if predicate(i) {
+			if n != i {
+				for _, c := range columns {
+					c.Move(n, i)
+				}
+			}
+			n++
+		}
+	}
+	for _, c := range columns {
+		c.Truncate(n)
+	}
+	return n
+}
+
+// columnsSorter sorts a key slice while applying the same permutation to parallel columns.
+type columnsSorter[K ordered] struct {
+	keys    []K
+	columns []Column
+}
+
+func (s columnsSorter[K]) Len() int {
+	return len(s.keys)
+}
+
+func (s columnsSorter[K]) Less(i, j int) bool {
+	return s.keys[i] < s.keys[j]
+}
+
+func (s columnsSorter[K]) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	for _, c := range s.columns {
+		c.Swap(i, j)
+	}
+}
+
+// SliceColumnsSortBy sorts the key slice in ascending order, applying the same permutation to all parallel columns.
+// The sort is not stable, use SliceColumnsSortStableBy if the original order of equal keys must be retained.
+// Sorting makes a small constant number of allocations which does not grow with the number of rows.
+// Panics if the columns have different lengths than the keys.
+func SliceColumnsSortBy[K ordered](keys []K, columns ...Column) {
+	if n := columnsLen(columns); len(columns) > 0 && n != len(keys) {
+		panic("bulk: parallel columns have different lengths")
+	}
+	sort.Sort(columnsSorter[K]{keys: keys, columns: columns})
+}
+
+// SliceColumnsSortStableBy sorts the key slice in ascending order, applying the same permutation to all parallel
+// columns. Rows with equal keys retain their original order. Allocations match SliceColumnsSortBy.
+// Panics if the columns have different lengths than the keys.
+func SliceColumnsSortStableBy[K ordered](keys []K, columns ...Column) {
+	if n := columnsLen(columns); len(columns) > 0 && n != len(keys) {
+		panic("bulk: parallel columns have different lengths")
+	}
+	sort.Stable(columnsSorter[K]{keys: keys, columns: columns})
+}
@@ -0,0 +1,161 @@
+package bulk
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSliceColumnsFilterInPlace(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceTestCases {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			values := sliceDup(tt.input)
+			labels := SliceTransform(strconv.Itoa, values)
+			n := SliceColumnsFilterInPlace(func(row int) bool {
+				return tt.testFunc(values[row])
+			}, SliceColumn(&values), SliceColumn(&labels))
+
+			assert.Equal(t, len(tt.expectTrue), n)
+			require.Len(t, values, n)
+			require.Len(t, labels, n)
+			if n > 0 {
+				assert.Equal(t, tt.expectTrue, values)
+				assert.Equal(t, SliceTransform(strconv.Itoa, tt.expectTrue), labels)
+			}
+		})
+	}
+
+	t.Run("struct_of_arrays", func(t *testing.T) {
+		ids := []int64{1, 2, 3, 4, 5}
+		scores := []float64{0.1, 0.8, 0.3, 0.9, 0.5}
+		names := []string{"a", "b", "c", "d", "e"}
+		idsPtr := &ids[0]
+		n := SliceColumnsFilterInPlace(func(row int) bool {
+			return scores[row] >= 0.5
+		}, SliceColumn(&ids), SliceColumn(&scores), SliceColumn(&names))
+
+		assert.Equal(t, 3, n)
+		assert.Equal(t, []int64{2, 4, 5}, ids)
+		assert.Equal(t, []float64{0.8, 0.9, 0.5}, scores)
+		assert.Equal(t, []string{"b", "d", "e"}, names)
+		assert.Same(t, idsPtr, &ids[0]) // no allocation
+	})
+
+	t.Run("row_index_order", func(t *testing.T) {
+		values := []int{5, 6, 7}
+		var rows []int
+		SliceColumnsFilterInPlace(func(row int) bool {
+			rows = append(rows, row)
+			return row != 1
+		}, SliceColumn(&values))
+		assert.Equal(t, []int{0, 1, 2}, rows)
+		assert.Equal(t, []int{5, 7}, values)
+	})
+
+	t.Run("no_columns", func(t *testing.T) {
+		assert.Equal(t, 0, SliceColumnsFilterInPlace(func(row int) bool { return true }))
+	})
+
+	t.Run("mismatched_lengths", func(t *testing.T) {
+		a, b := []int{1, 2}, []string{"a"}
+		assert.Panics(t, func() {
+			SliceColumnsFilterInPlace(func(row int) bool { return true }, SliceColumn(&a), SliceColumn(&b))
+		})
+	})
+}
+
+func TestSliceColumnsSortBy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("permutes_columns", func(t *testing.T) {
+		keys := []int64{30, 10, 20}
+		names := []string{"c", "a", "b"}
+		scores := []float64{3, 1, 2}
+		SliceColumnsSortBy(keys, SliceColumn(&names), SliceColumn(&scores))
+		assert.Equal(t, []int64{10, 20, 30}, keys)
+		assert.Equal(t, []string{"a", "b", "c"}, names)
+		assert.Equal(t, []float64{1, 2, 3}, scores)
+	})
+
+	t.Run("string_keys", func(t *testing.T) {
+		keys := []string{"b", "c", "a"}
+		ids := []int{2, 3, 1}
+		SliceColumnsSortBy(keys, SliceColumn(&ids))
+		assert.Equal(t, []string{"a", "b", "c"}, keys)
+		assert.Equal(t, []int{1, 2, 3}, ids)
+	})
+
+	t.Run("keys_only", func(t *testing.T) {
+		keys := []int{3, 1, 2}
+		SliceColumnsSortBy(keys)
+		assert.Equal(t, []int{1, 2, 3}, keys)
+	})
+
+	t.Run("mismatched_lengths", func(t *testing.T) {
+		ids := []int{1}
+		assert.Panics(t, func() { SliceColumnsSortBy([]int{2, 1}, SliceColumn(&ids)) })
+	})
+}
+
+func TestSliceColumnsSortStableBy(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]int, 100)
+	order := make([]int, 100)
+	for i := range keys {
+		keys[i] = (i * 7) % 5
+		order[i] = i
+	}
+	SliceColumnsSortStableBy(keys, SliceColumn(&order))
+	for i := 1; i < len(keys); i++ {
+		require.LessOrEqual(t, keys[i-1], keys[i])
+		if keys[i-1] == keys[i] {
+			assert.Less(t, order[i-1], order[i])
+		}
+	}
+	for i := range keys {
+		assert.Equal(t, (order[i]*7)%5, keys[i])
+	}
+
+	ids := []int{1}
+	assert.Panics(t, func() { SliceColumnsSortStableBy([]int{2, 1}, SliceColumn(&ids)) })
+}
+
+// TestSliceColumnsAllocations is not run in parallel so that allocation measurements only include the operation.
+func TestSliceColumnsAllocations(t *testing.T) {
+	newColumns := func(rows int) ([]int, []Column) {
+		keys := make([]int, rows)
+		ids := make([]int, rows)
+		names := make([]string, rows)
+		for i := range keys {
+			keys[i] = (i * 7) % rows
+			ids[i] = i
+		}
+		return keys, []Column{SliceColumn(&ids), SliceColumn(&names)}
+	}
+
+	t.Run("filter", func(t *testing.T) {
+		_, columns := newColumns(100)
+		assert.Equal(t, 0.0, testing.AllocsPerRun(100, func() {
+			SliceColumnsFilterInPlace(func(row int) bool { return true }, columns...)
+		}))
+	})
+	t.Run("sort_constant", func(t *testing.T) {
+		sortAllocs := func(rows int, sorter func(keys []int, columns ...Column)) float64 {
+			keys, columns := newColumns(rows)
+			return testing.AllocsPerRun(10, func() {
+				keys[0], keys[len(keys)-1] = keys[len(keys)-1], keys[0]
+				sorter(keys, columns...)
+			})
+		}
+		for _, sorter := range []func(keys []int, columns ...Column){SliceColumnsSortBy[int], SliceColumnsSortStableBy[int]} {
+			small := sortAllocs(10, sorter)
+			assert.LessOrEqual(t, small, 2.0)
+			assert.Equal(t, small, sortAllocs(10000, sorter))
+		}
+	})
+}
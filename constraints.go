@@ -0,0 +1,11 @@
+package bulk
+
+// integer is a constraint permitting any integer type.
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// ordered is a constraint permitting any type which supports the < operator.
+type ordered interface {
+	integer | ~float32 | ~float64 | ~string
+}
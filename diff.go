@@ -0,0 +1,264 @@
+package bulk
+
+// DiffOpKind identifies the type of edit described by a DiffOp.
+type DiffOpKind int
+
+const (
+	// DiffEqual indicates the range of A is equal to the range of B.
+	DiffEqual DiffOpKind = iota
+	// DiffDelete indicates the range of A is removed. The B range is empty, positioned where the deletion occurs.
+	DiffDelete
+	// DiffInsert indicates the range of B is inserted. The A range is empty, positioned where the insertion occurs.
+	DiffInsert
+)
+
+func (k DiffOpKind) String() string {
+	switch k {
+	case DiffEqual:
+		return "equal"
+	case DiffDelete:
+		return "delete"
+	case DiffInsert:
+		return "insert"
+	default:
+		return "unknown"
+	}
+}
+
+// DiffOp is a single operation of an edit script, describing the ranges [AStart, AEnd) of the original slice and
+// [BStart, BEnd) of the target slice which the operation covers. Insert operations carry the inserted values, so a
+// script can be applied with only the original slice.
+type DiffOp[T any] struct {
+	Kind   DiffOpKind
+	AStart int
+	AEnd   int
+	BStart int
+	BEnd   int
+	// Values holds the elements of the target slice inserted by a DiffInsert operation, nil for other kinds.
+	Values []T
+}
+
+// SliceDiff returns a minimal edit script transforming slice a into slice b, computed using Myers' algorithm.
+// Operations are ordered by position and adjacent operations of the same kind are merged into a single range.
+// Runs in O((N+M)D) time where D is the number of differences, using O(N+M) memory.
+func SliceDiff[T comparable](a, b []T) []DiffOp[T] {
+	return SliceDiffFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// SliceDiffFunc returns a minimal edit script transforming slice a into slice b, using the equal function to compare
+// elements. See SliceDiff for details.
+func SliceDiffFunc[T any](a, b []T, equal func(x, y T) bool) []DiffOp[T] {
+	// common prefix and suffix are trimmed to reduce the search space
+	var prefix int
+	for prefix < len(a) && prefix < len(b) && equal(a[prefix], b[prefix]) {
+		prefix++
+	}
+	var suffix int
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && equal(a[len(a)-1-suffix], b[len(b)-1-suffix]) {
+		suffix++
+	}
+
+	var ops []DiffOp[T]
+	if prefix > 0 {
+		ops = append(ops, DiffOp[T]{Kind: DiffEqual, AStart: 0, AEnd: prefix, BStart: 0, BEnd: prefix})
+	}
+	aMid, bMid := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	ops = myersDiff(ops, len(aMid), len(bMid), prefix, prefix, func(x, y int) bool { return equal(aMid[x], bMid[y]) })
+	if suffix > 0 {
+		ops = appendDiffOp(ops, DiffEqual, len(a)-suffix, len(b)-suffix)
+		ops[len(ops)-1].AEnd, ops[len(ops)-1].BEnd = len(a), len(b)
+	}
+
+	// inserted values are copied into a single allocation shared by the insert operations
+	var inserted int
+	for _, op := range ops {
+		if op.Kind == DiffInsert {
+			inserted += op.BEnd - op.BStart
+		}
+	}
+	if inserted > 0 {
+		values := make([]T, 0, inserted)
+		for i := range ops {
+			if ops[i].Kind == DiffInsert {
+				start := len(values)
+				values = append(values, b[ops[i].BStart:ops[i].BEnd]...)
+				ops[i].Values = values[start:len(values):len(values)]
+			}
+		}
+	}
+	return ops
+}
+
+// myersDiff appends the edit script between sequences of length n and m onto ops, offsetting positions by the
+// provided starting positions. The equal function compares elements by index.
+// Uses the linear space variant of Myers' algorithm, recursively dividing the problem at the middle snake of an optimal
+// path, so memory is O(N+M) rather than proportional to the square of the differences.
+func myersDiff[T any](ops []DiffOp[T], n, m, aOffset, bOffset int, equal func(x, y int) bool) []DiffOp[T] {
+	if n == 0 && m == 0 {
+		return ops
+	}
+
+	size := (n+m+1)/2 + 1
+	s := &myersSearch{equal: equal, vf: make([]int, 2*size+1), vb: make([]int, 2*size+1)}
+	s.findPath(0, 0, n, m)
+
+	emit := func(kind DiffOpKind, x, y int) {
+		ops = appendDiffOp(ops, kind, aOffset+x, bOffset+y)
+		last := &ops[len(ops)-1]
+		switch kind {
+		case DiffEqual:
+			last.AEnd, last.BEnd = aOffset+x+1, bOffset+y+1
+		case DiffDelete:
+			last.AEnd = aOffset + x + 1
+		case DiffInsert:
+			last.BEnd = bOffset + y + 1
+		}
+	}
+	diagonal := func(x1, y1, x2, y2 int) (int, int) {
+		for x1 < x2 && y1 < y2 && equal(x1, y1) {
+			emit(DiffEqual, x1, y1)
+			x1++
+			y1++
+		}
+		return x1, y1
+	}
+	for i := 1; i < len(s.path); i++ {
+		x1, y1 := s.path[i-1][0], s.path[i-1][1]
+		x2, y2 := s.path[i][0], s.path[i][1]
+		x1, y1 = diagonal(x1, y1, x2, y2)
+		if x2-x1 < y2-y1 {
+			emit(DiffInsert, x1, y1)
+			y1++
+		} else if x2-x1 > y2-y1 {
+			emit(DiffDelete, x1, y1)
+			x1++
+		}
+		diagonal(x1, y1, x2, y2)
+	}
+	return ops
+}
+
+// myersSearch holds the state of a linear space Myers search. The frontier buffers are shared by every level of the
+// recursion, since each middle snake is found before recursing.
+type myersSearch struct {
+	equal  func(x, y int) bool
+	vf, vb []int    // furthest reaching x (forward) and y (backward) per diagonal, offset by the buffer midpoint
+	path   [][2]int // points of the optimal path, consecutive points joined by at most one edit and a diagonal
+}
+
+// findPath appends the points of an optimal path through the box onto the search path, returning false if the box is
+// empty and no points were added.
+func (s *myersSearch) findPath(left, top, right, bottom int) bool {
+	startX, startY, endX, endY, ok := s.midpoint(left, top, right, bottom)
+	if !ok {
+		return false
+	}
+	if !s.findPath(left, top, startX, startY) {
+		s.path = append(s.path, [2]int{startX, startY})
+	}
+	if !s.findPath(endX, endY, right, bottom) {
+		s.path = append(s.path, [2]int{endX, endY})
+	}
+	return true
+}
+
+// midpoint searches forward from the top left and backward from the bottom right of the box until the searches
+// overlap, returning the start and end of the middle snake of an optimal path.
+func (s *myersSearch) midpoint(left, top, right, bottom int) (int, int, int, int, bool) {
+	width, height := right-left, bottom-top
+	if width+height == 0 {
+		return 0, 0, 0, 0, false
+	}
+	maxD := (width + height + 1) / 2
+	delta := width - height
+	offset := len(s.vf) / 2
+	vf, vb := s.vf, s.vb
+	vf[offset+1] = left
+	vb[offset+1] = bottom
+
+	for d := 0; d <= maxD; d++ {
+		// forward step along diagonals k = x - y (relative to the top left)
+		for k := d; k >= -d; k -= 2 {
+			var x, px int
+			if k == -d || (k != d && vf[offset+k-1] < vf[offset+k+1]) {
+				x = vf[offset+k+1]
+				px = x
+			} else {
+				px = vf[offset+k-1]
+				x = px + 1
+			}
+			y := top + (x - left) - k
+			py := y
+			if d != 0 && x == px {
+				py = y - 1
+			}
+			for x < right && y < bottom && s.equal(x, y) {
+				x++
+				y++
+			}
+			vf[offset+k] = x
+			if c := k - delta; delta%2 != 0 && c >= -(d-1) && c <= d-1 && y >= vb[offset+c] {
+				return px, py, x, y, true
+			}
+		}
+		// backward step along diagonals c = k - delta (relative to the bottom right)
+		for c := d; c >= -d; c -= 2 {
+			var y, py int
+			if c == -d || (c != d && vb[offset+c-1] > vb[offset+c+1]) {
+				y = vb[offset+c+1]
+				py = y
+			} else {
+				py = vb[offset+c-1]
+				y = py - 1
+			}
+			k := c + delta
+			x := left + (y - top) + k
+			px := x
+			if d != 0 && y == py {
+				px = x + 1
+			}
+			for x > left && y > top && s.equal(x-1, y-1) {
+				x--
+				y--
+			}
+			vb[offset+c] = y
+			if delta%2 == 0 && k >= -d && k <= d && x <= vf[offset+k] {
+				return x, y, px, py, true
+			}
+		}
+	}
+	return 0, 0, 0, 0, false // unreachable, the searches always overlap by round maxD
+}
+
+// appendDiffOp starts a new operation at the provided positions, unless the last operation is of the same kind.
+func appendDiffOp[T any](ops []DiffOp[T], kind DiffOpKind, aPos, bPos int) []DiffOp[T] {
+	if len(ops) > 0 && ops[len(ops)-1].Kind == kind {
+		return ops
+	}
+	return append(ops, DiffOp[T]{Kind: kind, AStart: aPos, AEnd: aPos, BStart: bPos, BEnd: bPos})
+}
+
+// SliceApplyDiff replays an edit script produced by SliceDiff or SliceDiffFunc against the original slice a,
+// returning a new slice equal to the target. Equal ranges are copied from a and inserted values are taken from the
+// insert operations. The result is allocated to the exact size.
+func SliceApplyDiff[T any](a []T, ops []DiffOp[T]) []T {
+	var size int
+	for _, op := range ops {
+		switch op.Kind {
+		case DiffEqual:
+			size += op.AEnd - op.AStart
+		case DiffInsert:
+			size += len(op.Values)
+		}
+	}
+	result := make([]T, 0, size)
+	for _, op := range ops {
+		switch op.Kind {
+		case DiffEqual:
+			result = append(result, a[op.AStart:op.AEnd]...)
+		case DiffInsert:
+			result = append(result, op.Values...)
+		}
+	}
+	return result
+}
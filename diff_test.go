@@ -0,0 +1,216 @@
+package bulk
+
+import (
+	"math/rand"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a        []string
+		b        []string
+		expected []DiffOp[string]
+	}{
+		{
+			name: "both_empty",
+		},
+		{
+			name: "equal",
+			a:    []string{"a", "b", "c"},
+			b:    []string{"a", "b", "c"},
+			expected: []DiffOp[string]{
+				{Kind: DiffEqual, AStart: 0, AEnd: 3, BStart: 0, BEnd: 3},
+			},
+		},
+		{
+			name: "all_inserted",
+			b:    []string{"a", "b"},
+			expected: []DiffOp[string]{
+				{Kind: DiffInsert, AStart: 0, AEnd: 0, BStart: 0, BEnd: 2, Values: []string{"a", "b"}},
+			},
+		},
+		{
+			name: "all_deleted",
+			a:    []string{"a", "b"},
+			expected: []DiffOp[string]{
+				{Kind: DiffDelete, AStart: 0, AEnd: 2, BStart: 0, BEnd: 0},
+			},
+		},
+		{
+			name: "replace_middle",
+			a:    []string{"a", "b", "c", "d"},
+			b:    []string{"a", "x", "y", "d"},
+			expected: []DiffOp[string]{
+				{Kind: DiffEqual, AStart: 0, AEnd: 1, BStart: 0, BEnd: 1},
+				{Kind: DiffDelete, AStart: 1, AEnd: 3, BStart: 1, BEnd: 1},
+				{Kind: DiffInsert, AStart: 3, AEnd: 3, BStart: 1, BEnd: 3, Values: []string{"x", "y"}},
+				{Kind: DiffEqual, AStart: 3, AEnd: 4, BStart: 3, BEnd: 4},
+			},
+		},
+		{
+			name: "insert_middle",
+			a:    []string{"a", "c"},
+			b:    []string{"a", "b", "c"},
+			expected: []DiffOp[string]{
+				{Kind: DiffEqual, AStart: 0, AEnd: 1, BStart: 0, BEnd: 1},
+				{Kind: DiffInsert, AStart: 1, AEnd: 1, BStart: 1, BEnd: 2, Values: []string{"b"}},
+				{Kind: DiffEqual, AStart: 1, AEnd: 2, BStart: 2, BEnd: 3},
+			},
+		},
+		{
+			name: "myers_example",
+			a:    strings.Split("ABCABBA", ""),
+			b:    strings.Split("CBABAC", ""),
+			expected: []DiffOp[string]{
+				{Kind: DiffDelete, AStart: 0, AEnd: 2, BStart: 0, BEnd: 0},
+				{Kind: DiffEqual, AStart: 2, AEnd: 3, BStart: 0, BEnd: 1},
+				{Kind: DiffDelete, AStart: 3, AEnd: 4, BStart: 1, BEnd: 1},
+				{Kind: DiffEqual, AStart: 4, AEnd: 5, BStart: 1, BEnd: 2},
+				{Kind: DiffInsert, AStart: 5, AEnd: 5, BStart: 2, BEnd: 3, Values: []string{"A"}},
+				{Kind: DiffEqual, AStart: 5, AEnd: 7, BStart: 3, BEnd: 5},
+				{Kind: DiffInsert, AStart: 7, AEnd: 7, BStart: 5, BEnd: 6, Values: []string{"C"}},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			ops := SliceDiff(tt.a, tt.b)
+
+			assert.Equal(t, tt.expected, ops)
+			assert.Equal(t, sliceDup(tt.b), sliceDup(SliceApplyDiff(tt.a, ops)))
+		})
+	}
+}
+
+func TestSliceDiffFunc(t *testing.T) {
+	t.Parallel()
+
+	a := []string{"Alpha", "beta", "Gamma"}
+	b := []string{"alpha", "BETA", "delta"}
+	ops := SliceDiffFunc(a, b, strings.EqualFold)
+
+	assert.Equal(t, []DiffOp[string]{
+		{Kind: DiffEqual, AStart: 0, AEnd: 2, BStart: 0, BEnd: 2},
+		{Kind: DiffDelete, AStart: 2, AEnd: 3, BStart: 2, BEnd: 2},
+		{Kind: DiffInsert, AStart: 3, AEnd: 3, BStart: 2, BEnd: 3, Values: []string{"delta"}},
+	}, ops)
+	// equal ranges are copied from a
+	assert.Equal(t, []string{"Alpha", "beta", "delta"}, SliceApplyDiff(a, ops))
+}
+
+func TestSliceDiffMinimal(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(42))
+	randSlice := func() []int {
+		s := make([]int, rng.Intn(20))
+		for i := range s {
+			s[i] = rng.Intn(4)
+		}
+		return s
+	}
+	lcsLen := func(a, b []int) int {
+		dp := make([][]int, len(a)+1)
+		for i := range dp {
+			dp[i] = make([]int, len(b)+1)
+		}
+		for i := len(a) - 1; i >= 0; i-- {
+			for j := len(b) - 1; j >= 0; j-- {
+				if a[i] == b[j] {
+					dp[i][j] = dp[i+1][j+1] + 1
+				} else if dp[i+1][j] > dp[i][j+1] {
+					dp[i][j] = dp[i+1][j]
+				} else {
+					dp[i][j] = dp[i][j+1]
+				}
+			}
+		}
+		return dp[0][0]
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := randSlice(), randSlice()
+		ops := SliceDiff(a, b)
+
+		var edits, aPos, bPos int
+		for j, op := range ops {
+			assert.Equal(t, aPos, op.AStart)
+			assert.Equal(t, bPos, op.BStart)
+			if j > 0 {
+				assert.NotEqual(t, ops[j-1].Kind, op.Kind)
+			}
+			switch op.Kind {
+			case DiffEqual:
+				assert.Equal(t, a[op.AStart:op.AEnd], b[op.BStart:op.BEnd])
+			case DiffDelete:
+				assert.Equal(t, op.BStart, op.BEnd)
+				edits += op.AEnd - op.AStart
+			case DiffInsert:
+				assert.Equal(t, op.AStart, op.AEnd)
+				assert.Equal(t, b[op.BStart:op.BEnd], op.Values)
+				edits += op.BEnd - op.BStart
+			}
+			aPos, bPos = op.AEnd, op.BEnd
+		}
+		assert.Equal(t, len(a), aPos)
+		assert.Equal(t, len(b), bPos)
+		assert.Equal(t, len(a)+len(b)-2*lcsLen(a, b), edits)
+		assert.Equal(t, sliceDup(b), sliceDup(SliceApplyDiff(a, ops)))
+	}
+}
+
+// TestSliceDiffLinearMemory is not run in parallel so that allocation measurements only include the diff.
+func TestSliceDiffLinearMemory(t *testing.T) {
+	// unrelated inputs maximize the differences, which would require O(D^2) memory if every frontier were retained
+	const n = 5000
+	a := make([]int, n)
+	b := make([]int, n)
+	for i := range a {
+		a[i] = i
+		b[i] = n + i
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	ops := SliceDiff(a, b)
+	runtime.ReadMemStats(&after)
+
+	assert.Equal(t, []DiffOp[int]{
+		{Kind: DiffDelete, AStart: 0, AEnd: n, BStart: 0, BEnd: 0},
+		{Kind: DiffInsert, AStart: n, AEnd: n, BStart: 0, BEnd: n, Values: b},
+	}, ops)
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(1<<20))
+}
+
+func TestSliceApplyDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("exact_capacity", func(t *testing.T) {
+		a := []int{1, 2, 3, 4}
+		b := []int{0, 2, 3, 5, 6}
+		result := SliceApplyDiff(a, SliceDiff(a, b))
+
+		assert.Equal(t, b, result)
+		assert.Equal(t, len(b), cap(result))
+	})
+	t.Run("independent_of_target", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		b := []int{1, 7, 8, 3}
+		ops := SliceDiff(a, b)
+		b[1], b[2] = 0, 0 // the script retains its own copy of inserted values
+
+		assert.Equal(t, []int{1, 7, 8, 3}, SliceApplyDiff(a, ops))
+	})
+	t.Run("empty_script", func(t *testing.T) {
+		assert.Empty(t, SliceApplyDiff[int]([]int{1}, nil))
+	})
+}
@@ -0,0 +1,91 @@
+package bulk
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ElementError reports an error produced while processing a specific input element.
+// SliceIndex identifies which of the variadic input slices contained the element, and ElementIndex is the position
+// of the element within that slice.
+type ElementError struct {
+	SliceIndex   int
+	ElementIndex int
+	Err          error
+}
+
+func (e *ElementError) Error() string {
+	return "slice " + strconv.Itoa(e.SliceIndex) + " element " + strconv.Itoa(e.ElementIndex) + ": " + e.Err.Error()
+}
+
+// Unwrap returns the original error.
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// ElementErrors collects an ElementError for each input element which failed processing, in input order.
+// errors.Is and errors.As match against any of the contained errors.
+type ElementErrors []*ElementError
+
+func (e ElementErrors) Error() string {
+	var sb strings.Builder
+	for i, err := range e {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(err.Error())
+	}
+	return sb.String()
+}
+
+// Unwrap returns the contained errors.
+func (e ElementErrors) Unwrap() []error {
+	result := make([]error, len(e))
+	for i, err := range e {
+		result[i] = err
+	}
+	return result
+}
+
+// Is reports whether any contained error matches the target.
+func (e ElementErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first contained error that matches the target, and if so, sets target to that error value.
+func (e ElementErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// PanicError reports a panic recovered from a user callback by a Recover variant.
+// It is returned wrapped in an *ElementError which identifies the element being processed when the panic occurred.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value interface{}
+	// Stack is the stack trace of the panicking goroutine, captured when the panic was recovered.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error, otherwise nil.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package bulk
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testCodeError struct {
+	code int
+}
+
+func (e testCodeError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+func TestElementError(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New("bad value")
+	err := &ElementError{SliceIndex: 1, ElementIndex: 3, Err: cause}
+	assert.Equal(t, "slice 1 element 3: bad value", err.Error())
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, cause, errors.Unwrap(err))
+}
+
+func TestElementErrors(t *testing.T) {
+	t.Parallel()
+
+	causeA := errors.New("a")
+	errs := ElementErrors{
+		{SliceIndex: 0, ElementIndex: 1, Err: causeA},
+		{SliceIndex: 2, ElementIndex: 0, Err: testCodeError{code: 7}},
+	}
+
+	t.Run("error", func(t *testing.T) {
+		assert.Equal(t, "slice 0 element 1: a\nslice 2 element 0: code 7", errs.Error())
+	})
+
+	t.Run("is", func(t *testing.T) {
+		assert.ErrorIs(t, errs, causeA)
+		assert.NotErrorIs(t, errs, errors.New("a"))
+	})
+
+	t.Run("as", func(t *testing.T) {
+		var codeErr testCodeError
+		assert.ErrorAs(t, errs, &codeErr)
+		assert.Equal(t, 7, codeErr.code)
+
+		var elemErr *ElementError
+		assert.ErrorAs(t, errs, &elemErr)
+		assert.Equal(t, 1, elemErr.ElementIndex)
+	})
+
+	t.Run("unwrap", func(t *testing.T) {
+		unwrapped := errs.Unwrap()
+		assert.Len(t, unwrapped, 2)
+		assert.Equal(t, errs[0], unwrapped[0])
+	})
+}
+
+func assertElementError(t *testing.T, err error, sliceIdx, elemIdx int, causeMsg string) {
+	t.Helper()
+
+	var elemErr *ElementError
+	if assert.ErrorAs(t, err, &elemErr) {
+		assert.Equal(t, sliceIdx, elemErr.SliceIndex)
+		assert.Equal(t, elemIdx, elemErr.ElementIndex)
+		assert.EqualError(t, elemErr.Err, causeMsg)
+		assert.Equal(t, elemErr.Err, errors.Unwrap(err))
+	}
+}
+
+func TestPanicError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("non_error_value", func(t *testing.T) {
+		err := &PanicError{Value: 42}
+		assert.Equal(t, "panic: 42", err.Error())
+		assert.NoError(t, err.Unwrap())
+	})
+
+	t.Run("error_value", func(t *testing.T) {
+		cause := errors.New("cause")
+		err := &PanicError{Value: cause}
+		assert.Equal(t, "panic: cause", err.Error())
+		assert.ErrorIs(t, err, cause)
+	})
+}
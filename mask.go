@@ -0,0 +1,91 @@
+package bulk
+
+import "math/bits"
+
+// Mask is a compact bitset recording the result of a predicate for each element position.
+// A single predicate evaluation can produce a Mask, which can then select the same rows from many parallel slices.
+type Mask struct {
+	words []uint64
+	n     int
+}
+
+// SliceMask evaluates the predicate for each element and returns a Mask with the passing positions set.
+// When multiple slices are provided, positions refer to the slices as if they were concatenated.
+func SliceMask[T any](predicate func(val T) bool, slices ...[]T) Mask {
+	n := sliceLen(slices)
+	m := Mask{words: make([]uint64, (n+63)/64), n: n}
+	var pos int
+	for _, slice := range slices {
+		for _, val := range slice {
+			if predicate(val) {
+				m.words[pos/64] |= 1 << (uint(pos) % 64)
+			}
+			pos++
+		}
+	}
+	return m
+}
+
+// Len returns the number of positions represented by the mask.
+func (m Mask) Len() int {
+	return m.n
+}
+
+// Test returns true if the position at index i is set. Panics if i is out of range.
+func (m Mask) Test(i int) bool {
+	if i < 0 || i >= m.n {
+		panic("bulk: mask index out of range")
+	}
+	return m.words[i/64]&(1<<(uint(i)%64)) != 0
+}
+
+// Count returns the number of set positions.
+func (m Mask) Count() int {
+	var count int
+	for _, w := range m.words {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// Indexes returns the set positions in ascending order, allocated to exactly the number of set positions.
+func (m Mask) Indexes() []int {
+	count := m.Count()
+	if count == 0 {
+		return nil
+	}
+	result := make([]int, 0, count)
+	for wordIdx, w := range m.words {
+		for w != 0 {
+			result = append(result, wordIdx*64+bits.TrailingZeros64(w))
+			w &= w - 1 // clear lowest set bit
+		}
+	}
+	return result
+}
+
+// SliceSelectMask returns the elements at positions set in the mask, preserving order.
+// When multiple slices are provided, positions refer to the slices as if they were concatenated.
+// The result is allocated to exactly the number of set positions. Panics if the slices are shorter than the mask.
+func SliceSelectMask[T any](mask Mask, slices ...[]T) []T {
+	if n := sliceLen(slices); n < mask.n {
+		panic("bulk: slices shorter than mask")
+	}
+	count := mask.Count()
+	if count == 0 {
+		return nil
+	}
+	result := make([]T, 0, count)
+	var pos int
+	for _, slice := range slices {
+		for i := range slice {
+			if pos >= mask.n {
+				return result
+			} else if mask.words[pos/64]&(1<<(uint(pos)%64)) != 0 {
+				result = append(result, slice[i])
+			}
+			pos++
+		}
+	}
+	return result
+}
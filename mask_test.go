@@ -0,0 +1,68 @@
+package bulk
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceMask(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceMultipleTestCases {
+		t.Run("multi-"+strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			mask := SliceMask(tt.testFunc, tt.slices...)
+			assert.Equal(t, sliceLen(tt.slices), mask.Len())
+			assert.Equal(t, len(tt.expectTrue), mask.Count())
+			if len(tt.expectTrue) == 0 {
+				assert.Nil(t, SliceSelectMask(mask, tt.slices...))
+			} else {
+				assert.Equal(t, tt.expectTrue, SliceSelectMask(mask, tt.slices...))
+			}
+		})
+	}
+
+	t.Run("test_and_indexes", func(t *testing.T) {
+		input := make([]int, 130)
+		for i := range input {
+			input[i] = i
+		}
+		mask := SliceMask(func(v int) bool { return v%63 == 0 }, input)
+		assert.Equal(t, []int{0, 63, 126}, mask.Indexes())
+		assert.True(t, mask.Test(63))
+		assert.False(t, mask.Test(64))
+		assert.Panics(t, func() { mask.Test(130) })
+		assert.Panics(t, func() { mask.Test(-1) })
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		mask := SliceMask(func(v int) bool { return true })
+		assert.Equal(t, 0, mask.Len())
+		assert.Equal(t, 0, mask.Count())
+		assert.Nil(t, mask.Indexes())
+	})
+}
+
+func TestSliceSelectMask(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parallel_columns", func(t *testing.T) {
+		ids := []int64{10, 20, 30, 40}
+		names := []string{"a", "b", "c", "d"}
+		mask := SliceMask(func(v int64) bool { return v != 20 }, ids)
+		selected := SliceSelectMask(mask, names)
+		assert.Equal(t, []string{"a", "c", "d"}, selected)
+		assert.Equal(t, 3, cap(selected))
+	})
+
+	t.Run("longer_slices", func(t *testing.T) {
+		mask := SliceMask(func(v int) bool { return true }, []int{1, 2})
+		assert.Equal(t, []string{"a", "b"}, SliceSelectMask(mask, []string{"a"}, []string{"b", "c"}))
+	})
+
+	t.Run("shorter_slices", func(t *testing.T) {
+		mask := SliceMask(func(v int) bool { return true }, []int{1, 2})
+		assert.Panics(t, func() { SliceSelectMask(mask, []string{"a"}) })
+	})
+}
@@ -0,0 +1,15 @@
+package pred
+
+// Pair is a composite key made from two comparable values.
+type Pair[A comparable, B comparable] struct {
+	First  A
+	Second B
+}
+
+// KeyPair returns a key function producing a composite Pair key from two key functions.
+// The result can be used directly with keyed bulk operations such as bulk.SliceToGroupsBy and bulk.SliceToCountsBy.
+func KeyPair[T any, A comparable, B comparable](first func(T) A, second func(T) B) func(T) Pair[A, B] {
+	return func(v T) Pair[A, B] {
+		return Pair[A, B]{First: first(v), Second: second(v)}
+	}
+}
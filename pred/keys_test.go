@@ -0,0 +1,39 @@
+package pred
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-analyze/bulk"
+)
+
+func TestKeyPair(t *testing.T) {
+	t.Parallel()
+
+	regionTier := KeyPair(func(u testUser) string { return u.region }, func(u testUser) string { return u.tier })
+
+	t.Run("key", func(t *testing.T) {
+		assert.Equal(t, Pair[string, string]{First: "us", Second: "basic"}, regionTier(testUsers[0]))
+	})
+
+	t.Run("counts_by", func(t *testing.T) {
+		counts := bulk.SliceToCountsBy(regionTier, testUsers, []testUser{{region: "ap", tier: "vip"}})
+		assert.Equal(t, map[Pair[string, string]]int{
+			{First: "us", Second: "basic"}: 1,
+			{First: "eu", Second: "vip"}:   1,
+			{First: "ap", Second: "basic"}: 1,
+			{First: "us", Second: "vip"}:   1,
+			{First: "ap", Second: "vip"}:   2,
+		}, counts)
+	})
+
+	t.Run("groups_by", func(t *testing.T) {
+		activeAge := KeyPair(func(u testUser) bool { return u.active }, func(u testUser) bool { return u.age >= 30 })
+		groups := bulk.SliceToGroupsBy(activeAge, testUsers)
+		assert.Len(t, groups, 3)
+		assert.Equal(t, []string{"a", "e"}, userNames(groups[Pair[bool, bool]{First: true, Second: false}]))
+		assert.Equal(t, []string{"b", "c"}, userNames(groups[Pair[bool, bool]{First: true, Second: true}]))
+		assert.Equal(t, []string{"d"}, userNames(groups[Pair[bool, bool]{First: false, Second: true}]))
+	})
+}
@@ -0,0 +1,96 @@
+// Package pred provides combinators for building predicates and key functions used with bulk operations.
+//
+// Predicates are composed from simple building blocks rather than ad-hoc closures:
+//
+//	active := func(u User) bool { return u.Active }
+//	vip := pred.FieldEquals(func(u User) string { return u.Tier }, "vip")
+//	inRegion := pred.Field(func(u User) string { return u.Region }, pred.In(bulk.SliceToSet(regions)))
+//	selected := bulk.SliceFilter(pred.And(active, pred.Or(inRegion, vip)), users)
+package pred
+
+// ordered is a constraint permitting any type which supports the < operator.
+type ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// And returns a predicate which passes when all predicates pass. Evaluation stops at the first failing predicate.
+// With no predicates the result always passes.
+func And[T any](predicates ...func(T) bool) func(T) bool {
+	switch len(predicates) {
+	case 1:
+		return predicates[0]
+	case 2:
+		a, b := predicates[0], predicates[1]
+		return func(v T) bool { return a(v) && b(v) }
+	}
+	return func(v T) bool {
+		for _, p := range predicates {
+			if !p(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or returns a predicate which passes when any predicate passes. Evaluation stops at the first passing predicate.
+// With no predicates the result never passes.
+func Or[T any](predicates ...func(T) bool) func(T) bool {
+	switch len(predicates) {
+	case 1:
+		return predicates[0]
+	case 2:
+		a, b := predicates[0], predicates[1]
+		return func(v T) bool { return a(v) || b(v) }
+	}
+	return func(v T) bool {
+		for _, p := range predicates {
+			if p(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not returns a predicate which inverts the result of the provided predicate.
+func Not[T any](predicate func(T) bool) func(T) bool {
+	return func(v T) bool { return !predicate(v) }
+}
+
+// In returns a predicate which passes for values contained in the set, such as one created by bulk.SliceToSet.
+func In[T comparable](set map[T]struct{}) func(T) bool {
+	return func(v T) bool {
+		_, ok := set[v]
+		return ok
+	}
+}
+
+// NotIn returns a predicate which passes for values not contained in the set, such as one created by bulk.SliceToSet.
+func NotIn[T comparable](set map[T]struct{}) func(T) bool {
+	return func(v T) bool {
+		_, ok := set[v]
+		return !ok
+	}
+}
+
+// Equal returns a predicate which passes for values equal to the provided value.
+func Equal[T comparable](value T) func(T) bool {
+	return func(v T) bool { return v == value }
+}
+
+// Between returns a predicate which passes for values within the inclusive range [low, high].
+func Between[T ordered](low, high T) func(T) bool {
+	return func(v T) bool { return v >= low && v <= high }
+}
+
+// Field returns a predicate which applies the provided predicate to a value derived using the getter.
+func Field[T any, F any](getter func(T) F, predicate func(F) bool) func(T) bool {
+	return func(v T) bool { return predicate(getter(v)) }
+}
+
+// FieldEquals returns a predicate which passes when the value derived using the getter equals the provided value.
+func FieldEquals[T any, F comparable](getter func(T) F, value F) func(T) bool {
+	return func(v T) bool { return getter(v) == value }
+}
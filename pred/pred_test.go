@@ -0,0 +1,161 @@
+package pred
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-analyze/bulk"
+)
+
+type testUser struct {
+	name   string
+	region string
+	tier   string
+	active bool
+	age    int
+}
+
+var testUsers = []testUser{
+	{name: "a", region: "us", tier: "basic", active: true, age: 20},
+	{name: "b", region: "eu", tier: "vip", active: true, age: 35},
+	{name: "c", region: "ap", tier: "basic", active: true, age: 50},
+	{name: "d", region: "us", tier: "vip", active: false, age: 41},
+	{name: "e", region: "ap", tier: "vip", active: true, age: 28},
+}
+
+func userNames(users []testUser) []string {
+	return bulk.SliceTransform(func(u testUser) string { return u.name }, users)
+}
+
+func isPositive(v int) bool { return v > 0 }
+
+func isEven(v int) bool { return v%2 == 0 }
+
+func TestAnd(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		predicates []func(int) bool
+		expect     []int
+	}{
+		{name: "none", predicates: nil, expect: []int{-2, -1, 0, 1, 2}},
+		{name: "single", predicates: []func(int) bool{isPositive}, expect: []int{1, 2}},
+		{name: "two", predicates: []func(int) bool{isPositive, isEven}, expect: []int{2}},
+		{name: "three", predicates: []func(int) bool{isPositive, isEven, Not(Equal(2))}, expect: nil},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			result := bulk.SliceFilter(And(tt.predicates...), []int{-2, -1, 0, 1, 2})
+			if len(tt.expect) == 0 {
+				assert.Empty(t, result)
+			} else {
+				assert.Equal(t, tt.expect, result)
+			}
+		})
+	}
+
+	t.Run("short_circuit", func(t *testing.T) {
+		var calls int
+		counting := func(v int) bool { calls++; return true }
+		And(isPositive, counting, counting)(-1)
+		assert.Equal(t, 0, calls)
+	})
+}
+
+func TestOr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		predicates []func(int) bool
+		expect     []int
+	}{
+		{name: "none", predicates: nil, expect: nil},
+		{name: "single", predicates: []func(int) bool{isPositive}, expect: []int{1, 2}},
+		{name: "two", predicates: []func(int) bool{isPositive, isEven}, expect: []int{-2, 0, 1, 2}},
+		{name: "three", predicates: []func(int) bool{isPositive, isEven, Equal(-1)}, expect: []int{-2, -1, 0, 1, 2}},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			result := bulk.SliceFilter(Or(tt.predicates...), []int{-2, -1, 0, 1, 2})
+			if len(tt.expect) == 0 {
+				assert.Empty(t, result)
+			} else {
+				assert.Equal(t, tt.expect, result)
+			}
+		})
+	}
+
+	t.Run("short_circuit", func(t *testing.T) {
+		var calls int
+		counting := func(v int) bool { calls++; return false }
+		Or(isPositive, counting, counting)(1)
+		assert.Equal(t, 0, calls)
+	})
+}
+
+func TestNot(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, Not(isPositive)(-1))
+	assert.False(t, Not(isPositive)(1))
+}
+
+func TestInNotIn(t *testing.T) {
+	t.Parallel()
+
+	set := bulk.SliceToSet([]string{"us", "eu"})
+	regions := []string{"us", "ap", "eu", "sa"}
+	assert.Equal(t, []string{"us", "eu"}, bulk.SliceFilter(In(set), regions))
+	assert.Equal(t, []string{"ap", "sa"}, bulk.SliceFilter(NotIn(set), regions))
+
+	var nilSet map[string]struct{}
+	assert.False(t, In(nilSet)("us"))
+	assert.True(t, NotIn(nilSet)("us"))
+}
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, Equal("a")("a"))
+	assert.False(t, Equal("a")("b"))
+}
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{2, 3, 4}, bulk.SliceFilter(Between(2, 4), []int{1, 2, 3, 4, 5}))
+	assert.True(t, Between(1.5, 2.5)(2.5))
+	assert.False(t, Between("b", "d")("e"))
+	assert.False(t, Between(5, 1)(3))
+}
+
+func TestFieldEquals(t *testing.T) {
+	t.Parallel()
+
+	vip := FieldEquals(func(u testUser) string { return u.tier }, "vip")
+	assert.Equal(t, []string{"b", "d", "e"}, userNames(bulk.SliceFilter(vip, testUsers)))
+}
+
+func TestField(t *testing.T) {
+	t.Parallel()
+
+	age := func(u testUser) int { return u.age }
+	assert.Equal(t, []string{"b", "e"}, userNames(bulk.SliceFilter(Field(age, Between(25, 40)), testUsers)))
+}
+
+func TestCombined(t *testing.T) {
+	t.Parallel()
+
+	// active and (region in X or vip)
+	active := func(u testUser) bool { return u.active }
+	inRegion := Field(func(u testUser) string { return u.region }, In(bulk.SliceToSet([]string{"us", "eu"})))
+	vip := FieldEquals(func(u testUser) string { return u.tier }, "vip")
+	selected := bulk.SliceFilter(And(active, Or(inRegion, vip)), testUsers)
+	assert.Equal(t, []string{"a", "b", "e"}, userNames(selected))
+}
@@ -0,0 +1,59 @@
+package bulk
+
+// Change pairs the actual (Old) and desired (New) values for a key whose values differ.
+type Change[T any] struct {
+	Old T
+	New T
+}
+
+// Reconciliation describes the differences between a desired and actual collection, as returned by SliceReconcileBy.
+type Reconciliation[T any] struct {
+	// Added contains desired elements whose key does not exist in actual, in desired order.
+	Added []T
+	// Removed contains actual elements whose key does not exist in desired, in actual order.
+	Removed []T
+	// Changed contains elements whose key exists in both but whose values are not equal, in desired order.
+	Changed []Change[T]
+	// Unchanged contains desired elements whose key exists in both with equal values, in desired order.
+	Unchanged []T
+}
+
+// SliceReconcileBy compares desired elements against actual elements matched by the key generated using keyfunc,
+// and reports which elements must be added, removed, or changed to make actual match desired.
+// The equal function is used to compare elements with a matching key.
+// Keys should be unique within each slice. If actual contains duplicate keys, the last value is used for comparison.
+func SliceReconcileBy[T any, K comparable](keyfunc func(T) K, equal func(a, b T) bool, desired, actual []T) Reconciliation[T] {
+	var result Reconciliation[T]
+	actualIndex := SliceToIndexBy(keyfunc, actual)
+	desiredKeys := make(map[K]struct{}, len(desired))
+	for i, val := range desired {
+		key := keyfunc(val)
+		desiredKeys[key] = struct{}{}
+		if old, ok := actualIndex[key]; !ok {
+			if result.Added == nil { // allocate based on potential remaining
+				result.Added = make([]T, 0, capGuess(len(desired)-i))
+			}
+			result.Added = append(result.Added, val)
+		} else if !equal(old, val) {
+			if result.Changed == nil {
+				result.Changed = make([]Change[T], 0, capGuess(len(desired)-i))
+			}
+			result.Changed = append(result.Changed, Change[T]{Old: old, New: val})
+		} else {
+			if result.Unchanged == nil {
+				result.Unchanged = make([]T, 0, capGuess(len(desired)-i))
+			}
+			result.Unchanged = append(result.Unchanged, val)
+		}
+	}
+
+	for i, val := range actual {
+		if _, ok := desiredKeys[keyfunc(val)]; !ok {
+			if result.Removed == nil {
+				result.Removed = make([]T, 0, capGuess(len(actual)-i))
+			}
+			result.Removed = append(result.Removed, val)
+		}
+	}
+	return result
+}
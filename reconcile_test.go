@@ -0,0 +1,87 @@
+package bulk
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRule struct {
+	id     int
+	action string
+}
+
+func TestSliceReconcileBy(t *testing.T) {
+	t.Parallel()
+
+	ruleID := func(r testRule) int { return r.id }
+	ruleEqual := func(a, b testRule) bool { return a == b }
+
+	tests := []struct {
+		name     string
+		desired  []testRule
+		actual   []testRule
+		expected Reconciliation[testRule]
+	}{
+		{
+			name:     "both_empty",
+			expected: Reconciliation[testRule]{},
+		},
+		{
+			name:    "all_added",
+			desired: []testRule{{1, "allow"}, {2, "deny"}},
+			expected: Reconciliation[testRule]{
+				Added: []testRule{{1, "allow"}, {2, "deny"}},
+			},
+		},
+		{
+			name:   "all_removed",
+			actual: []testRule{{1, "allow"}, {2, "deny"}},
+			expected: Reconciliation[testRule]{
+				Removed: []testRule{{1, "allow"}, {2, "deny"}},
+			},
+		},
+		{
+			name:    "all_unchanged",
+			desired: []testRule{{2, "deny"}, {1, "allow"}},
+			actual:  []testRule{{1, "allow"}, {2, "deny"}},
+			expected: Reconciliation[testRule]{
+				Unchanged: []testRule{{2, "deny"}, {1, "allow"}},
+			},
+		},
+		{
+			name:    "mixed",
+			desired: []testRule{{5, "allow"}, {1, "deny"}, {3, "allow"}, {4, "log"}, {2, "deny"}},
+			actual:  []testRule{{6, "allow"}, {1, "allow"}, {2, "deny"}, {7, "log"}, {3, "deny"}},
+			expected: Reconciliation[testRule]{
+				Added:     []testRule{{5, "allow"}, {4, "log"}},
+				Removed:   []testRule{{6, "allow"}, {7, "log"}},
+				Changed:   []Change[testRule]{{Old: testRule{1, "allow"}, New: testRule{1, "deny"}}, {Old: testRule{3, "deny"}, New: testRule{3, "allow"}}},
+				Unchanged: []testRule{{2, "deny"}},
+			},
+		},
+		{
+			name:    "duplicate_actual_last_wins",
+			desired: []testRule{{1, "deny"}},
+			actual:  []testRule{{1, "allow"}, {1, "deny"}},
+			expected: Reconciliation[testRule]{
+				Unchanged: []testRule{{1, "deny"}},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			result := SliceReconcileBy(ruleID, ruleEqual, tt.desired, tt.actual)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("custom_equal", func(t *testing.T) {
+		result := SliceReconcileBy(ruleID, func(a, b testRule) bool { return true },
+			[]testRule{{1, "deny"}}, []testRule{{1, "allow"}})
+		assert.Equal(t, []testRule{{1, "deny"}}, result.Unchanged)
+		assert.Empty(t, result.Changed)
+	})
+}
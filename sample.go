@@ -0,0 +1,135 @@
+package bulk
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// randIntn returns a random int in [0, n) from the provided source, or the global source if rng is nil.
+func randIntn(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.Intn(n)
+	}
+	return rng.Intn(n)
+}
+
+// randFloat64 returns a random float64 in [0, 1) from the provided source, or the global source if rng is nil.
+func randFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
+
+// SliceSample returns n elements selected uniformly at random without replacement from all provided slices, using
+// reservoir sampling so the inputs are never concatenated. The order of the result is not meaningful. If the slices
+// contain n or fewer elements in total, a copy of all elements is returned. The rng may be nil to use the global
+// math/rand source, providing a seeded source makes the sample deterministic.
+func SliceSample[T any](n int, rng *rand.Rand, slices ...[]T) []T {
+	if total := sliceLen(slices); n > total {
+		n = total
+	}
+	if n <= 0 {
+		return nil
+	}
+	var seen int
+	reservoir := make([]T, 0, n)
+	for _, slice := range slices {
+		for _, val := range slice {
+			if seen < n {
+				reservoir = append(reservoir, val)
+			} else if j := randIntn(rng, seen+1); j < n {
+				reservoir[j] = val
+			}
+			seen++
+		}
+	}
+	return reservoir
+}
+
+// weightedSample is an element candidate within SliceSampleWeighted, held in a min-heap by key.
+type weightedSample[T any] struct {
+	key float64
+	val T
+}
+
+// SliceSampleWeighted returns up to n elements selected at random without replacement from all provided slices, with
+// the probability of selection proportional to the weight returned by weightfunc. Elements with a weight less than or
+// equal to zero (or NaN) are never selected. The weightfunc is invoked exactly once per element, and the result is
+// ordered as if the elements were drawn sequentially. The rng may be nil to use the global math/rand source.
+func SliceSampleWeighted[T any](n int, rng *rand.Rand, weightfunc func(T) float64, slices ...[]T) []T {
+	if total := sliceLen(slices); n > total {
+		n = total
+	}
+	if n <= 0 {
+		return nil
+	}
+	// Efraimidis-Spirakis A-ES: retain the n largest keys of u^(1/w), computed in log space as log(u)/w
+	var heap []weightedSample[T]
+	down := func(i int) {
+		for {
+			smallest := i
+			if l := 2*i + 1; l < len(heap) && heap[l].key < heap[smallest].key {
+				smallest = l
+			}
+			if r := 2*i + 2; r < len(heap) && heap[r].key < heap[smallest].key {
+				smallest = r
+			}
+			if smallest == i {
+				return
+			}
+			heap[i], heap[smallest] = heap[smallest], heap[i]
+			i = smallest
+		}
+	}
+	for _, slice := range slices {
+		for _, val := range slice {
+			w := weightfunc(val)
+			if !(w > 0) {
+				continue
+			}
+			key := math.Log(1-randFloat64(rng)) / w // 1-u avoids log(0)
+			if len(heap) < n {
+				if heap == nil {
+					heap = make([]weightedSample[T], 0, n)
+				}
+				heap = append(heap, weightedSample[T]{key: key, val: val})
+				for i := len(heap) - 1; i > 0; { // sift up
+					parent := (i - 1) / 2
+					if heap[parent].key <= heap[i].key {
+						break
+					}
+					heap[i], heap[parent] = heap[parent], heap[i]
+					i = parent
+				}
+			} else if key > heap[0].key {
+				heap[0] = weightedSample[T]{key: key, val: val}
+				down(0)
+			}
+		}
+	}
+	if len(heap) == 0 {
+		return nil
+	}
+
+	sort.Slice(heap, func(i, j int) bool { return heap[i].key > heap[j].key })
+	result := make([]T, len(heap))
+	for i, s := range heap {
+		result[i] = s.val
+	}
+	return result
+}
+
+// SliceShuffleInPlace randomly permutes the elements of all provided slices using a Fisher-Yates shuffle. The input
+// is shuffled directly when only one slice is non-empty, otherwise the elements are combined into a new allocation.
+// The rng may be nil to use the global math/rand source.
+// The input slices should not be used after this call, the returned slice should be used instead.
+func SliceShuffleInPlace[T any](rng *rand.Rand, slices ...[]T) []T {
+	result := sliceConcatReuse(slices)
+	for i := len(result) - 1; i > 0; i-- {
+		j := randIntn(rng, i+1)
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
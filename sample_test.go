@@ -0,0 +1,150 @@
+package bulk
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceSample(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fewer_than_n", func(t *testing.T) {
+		a := []int{1, 2}
+		b := []int{3}
+		result := SliceSample(5, rand.New(rand.NewSource(1)), a, b)
+
+		assert.Equal(t, []int{1, 2, 3}, result)
+		assert.Equal(t, 3, cap(result))
+	})
+	t.Run("large_n", func(t *testing.T) {
+		result := SliceSample(1_000_000_000, rand.New(rand.NewSource(1)), []int{1, 2}, []int{3})
+
+		assert.Equal(t, []int{1, 2, 3}, result)
+		assert.Equal(t, 3, cap(result))
+	})
+	t.Run("empty", func(t *testing.T) {
+		assert.Nil(t, SliceSample[int](3, nil))
+		assert.Nil(t, SliceSample(0, nil, []int{1, 2}))
+	})
+	t.Run("deterministic", func(t *testing.T) {
+		input := make([]int, 1000)
+		for i := range input {
+			input[i] = i
+		}
+		first := SliceSample(10, rand.New(rand.NewSource(7)), input[:400], input[400:])
+		second := SliceSample(10, rand.New(rand.NewSource(7)), input[:400], input[400:])
+
+		assert.Equal(t, first, second)
+		assert.Len(t, first, 10)
+		assert.Len(t, SliceToSet(first), 10) // without replacement
+	})
+	t.Run("uniform", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(42))
+		input := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		counts := make([]int, len(input))
+		const trials = 20_000
+		for i := 0; i < trials; i++ {
+			for _, v := range SliceSample(3, rng, input[:4], nil, input[4:]) {
+				counts[v]++
+			}
+		}
+
+		expected := trials * 3 / len(input)
+		for v, c := range counts {
+			assert.InDelta(t, expected, c, float64(expected)/10, "value %d", v)
+		}
+	})
+}
+
+func TestSliceSampleWeighted(t *testing.T) {
+	t.Parallel()
+
+	weight := func(v int) float64 { return float64(v) }
+
+	t.Run("excludes_non_positive", func(t *testing.T) {
+		result := SliceSampleWeighted(5, rand.New(rand.NewSource(1)), weight, []int{0, -1, 2}, []int{3})
+		sort.Ints(result)
+
+		assert.Equal(t, []int{2, 3}, result)
+	})
+	t.Run("large_n", func(t *testing.T) {
+		result := SliceSampleWeighted(1_000_000_000, rand.New(rand.NewSource(1)), weight, []int{1, 2})
+		sort.Ints(result)
+
+		assert.Equal(t, []int{1, 2}, result)
+	})
+	t.Run("empty", func(t *testing.T) {
+		assert.Nil(t, SliceSampleWeighted(3, nil, weight, []int{0}))
+		assert.Nil(t, SliceSampleWeighted(0, nil, weight, []int{1}))
+	})
+	t.Run("deterministic", func(t *testing.T) {
+		input := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		first := SliceSampleWeighted(3, rand.New(rand.NewSource(7)), weight, input)
+		second := SliceSampleWeighted(3, rand.New(rand.NewSource(7)), weight, input)
+
+		assert.Equal(t, first, second)
+		assert.Len(t, SliceToSet(first), 3)
+	})
+	t.Run("proportional", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(42))
+		input := []int{1, 2, 3, 4}
+		counts := make(map[int]int)
+		const trials = 20_000
+		for i := 0; i < trials; i++ {
+			counts[SliceSampleWeighted(1, rng, weight, input)[0]]++
+		}
+
+		for _, v := range input {
+			expected := float64(trials*v) / 10
+			assert.InDelta(t, expected, counts[v], expected/10, "value %d", v)
+		}
+	})
+	t.Run("draw_order", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(42))
+		var heavyFirst int
+		const trials = 2000
+		for i := 0; i < trials; i++ {
+			if SliceSampleWeighted(2, rng, weight, []int{1, 9})[0] == 9 {
+				heavyFirst++
+			}
+		}
+
+		assert.InDelta(t, trials*9/10, heavyFirst, trials/20)
+	})
+}
+
+func TestSliceShuffleInPlace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("permutation", func(t *testing.T) {
+		input := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		result := SliceShuffleInPlace(rand.New(rand.NewSource(3)), input)
+
+		assert.Equal(t, &input[0], &result[0])
+		sorted := sliceDup(result)
+		sort.Ints(sorted)
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, sorted)
+		assert.NotEqual(t, sorted, result)
+	})
+	t.Run("preserves_capacity_beyond_input", func(t *testing.T) {
+		buf := []int{1, 2, 3, 100, 200, 300}
+		result := SliceShuffleInPlace(rand.New(rand.NewSource(3)), buf[:3], []int{4})
+
+		assert.Len(t, result, 4)
+		assert.Equal(t, []int{100, 200, 300}, buf[3:])
+	})
+	t.Run("deterministic", func(t *testing.T) {
+		first := SliceShuffleInPlace(rand.New(rand.NewSource(5)), []string{"a", "b"}, []string{"c", "d", "e"})
+		second := SliceShuffleInPlace(rand.New(rand.NewSource(5)), []string{"a", "b"}, []string{"c", "d", "e"})
+
+		assert.Equal(t, first, second)
+		assert.Len(t, first, 5)
+	})
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, SliceShuffleInPlace[int](nil))
+		assert.Equal(t, []int{1}, SliceShuffleInPlace(nil, []int{1}))
+	})
+}
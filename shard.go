@@ -0,0 +1,144 @@
+package bulk
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// shardKey is a constraint permitting key types which have a stable hash across processes.
+type shardKey interface {
+	integer | ~string
+}
+
+// SliceShardBy distributes elements into n shards using a stable hash of the key generated by keyfunc.
+// The hash does not depend on the process, so the same key is assigned to the same shard across runs and machines.
+// Element order is preserved within each shard, and all shards share a single exact-size allocation.
+// May return the original slice as a shard if all elements are assigned to the same shard (no allocation).
+// Changing n reassigns most keys, use SliceShardConsistentBy if shards will be added over time.
+// Panics if n is not positive.
+func SliceShardBy[T any, K shardKey](n int, keyfunc func(T) K, slices ...[]T) [][]T {
+	checkShardCount(n)
+	return SlicePartitionN(func(val T) int {
+		return int(shardHash(keyfunc(val)) % uint64(n))
+	}, n, slices...)
+}
+
+// SliceShardByInto appends elements into the shards of dest using a stable hash of the key generated by keyfunc.
+// The number of shards is len(dest). Shard assignment matches SliceShardBy. Panics if dest is empty.
+func SliceShardByInto[T any, K shardKey](dest [][]T, keyfunc func(T) K, slices ...[]T) {
+	checkShardCount(len(dest))
+	n := uint64(len(dest))
+	for _, slice := range slices {
+		for _, val := range slice {
+			shard := shardHash(keyfunc(val)) % n
+			dest[shard] = append(dest[shard], val)
+		}
+	}
+}
+
+// SliceShardConsistentBy distributes elements into n shards using consistent hashing of the key generated by keyfunc.
+// Like SliceShardBy assignment is stable across processes, additionally when the number of shards grows from n to
+// n+1 only about 1/(n+1) of keys move, and they only move to the new shard.
+// Element order is preserved within each shard, and all shards share a single exact-size allocation.
+// May return the original slice as a shard if all elements are assigned to the same shard (no allocation).
+// Panics if n is not positive.
+func SliceShardConsistentBy[T any, K shardKey](n int, keyfunc func(T) K, slices ...[]T) [][]T {
+	checkShardCount(n)
+	return SlicePartitionN(func(val T) int {
+		return jumpHash(shardHash(keyfunc(val)), n)
+	}, n, slices...)
+}
+
+// SliceShardConsistentByInto appends elements into the shards of dest using consistent hashing of the key generated
+// by keyfunc. The number of shards is len(dest). Shard assignment matches SliceShardConsistentBy.
+// Panics if dest is empty.
+func SliceShardConsistentByInto[T any, K shardKey](dest [][]T, keyfunc func(T) K, slices ...[]T) {
+	checkShardCount(len(dest))
+	n := len(dest)
+	for _, slice := range slices {
+		for _, val := range slice {
+			shard := jumpHash(shardHash(keyfunc(val)), n)
+			dest[shard] = append(dest[shard], val)
+		}
+	}
+}
+
+// checkShardCount panics if the number of shards is not positive, since no shard could hold an element.
+func checkShardCount(n int) {
+	if n <= 0 {
+		panic(fmt.Sprintf("bulk: shard count %d must be positive", n))
+	}
+}
+
+// shardHash returns a process independent hash of the key.
+// Integers hash by value regardless of width, so int32(-1) and int64(-1) produce the same hash.
+func shardHash[K shardKey](key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return fnvHash(k)
+	case int:
+		return mixHash(uint64(k))
+	case int8:
+		return mixHash(uint64(k))
+	case int16:
+		return mixHash(uint64(k))
+	case int32:
+		return mixHash(uint64(k))
+	case int64:
+		return mixHash(uint64(k))
+	case uint:
+		return mixHash(uint64(k))
+	case uint8:
+		return mixHash(uint64(k))
+	case uint16:
+		return mixHash(uint64(k))
+	case uint32:
+		return mixHash(uint64(k))
+	case uint64:
+		return mixHash(k)
+	case uintptr:
+		return mixHash(uint64(k))
+	}
+
+	// named types with a supported underlying type
+	v := reflect.ValueOf(key)
+	switch v.Kind() {
+	case reflect.String:
+		return fnvHash(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mixHash(uint64(v.Int()))
+	default:
+		return mixHash(v.Uint())
+	}
+}
+
+// fnvHash returns the 64-bit FNV-1a hash of the string.
+func fnvHash(s string) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= 1099511628211
+	}
+	return mixHash(h)
+}
+
+// mixHash applies the splitmix64 finalizer, spreading entropy across all bits.
+func mixHash(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+// jumpHash maps the key to a bucket in [0, n) using the jump consistent hash algorithm by Lamping and Veach.
+func jumpHash(key uint64, n int) int {
+	var b, j int64 = -1, 0
+	for j < int64(n) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
@@ -0,0 +1,162 @@
+package bulk
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTenantID string
+
+type testRecord struct {
+	tenant string
+	value  int
+}
+
+func TestShardHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stable_values", func(t *testing.T) {
+		// hashes must never change, shard assignment is expected to be stable across releases and processes
+		assert.Equal(t, uint64(14611312287888043321), shardHash("tenant-a"))
+		assert.Equal(t, uint64(8370428498752086157), shardHash("tenant-b"))
+		assert.Equal(t, uint64(6238072747940578789), shardHash(1))
+		assert.Equal(t, uint64(13029008266876403067), shardHash(int64(-1)))
+	})
+
+	t.Run("integer_widths", func(t *testing.T) {
+		assert.Equal(t, shardHash(int64(-1)), shardHash(int8(-1)))
+		assert.Equal(t, shardHash(int64(-1)), shardHash(int32(-1)))
+		assert.Equal(t, shardHash(42), shardHash(uint16(42)))
+		assert.Equal(t, shardHash(42), shardHash(uint64(42)))
+		assert.Equal(t, shardHash(42), shardHash(uintptr(42)))
+		assert.Equal(t, shardHash(42), shardHash(uint(42)))
+		assert.Equal(t, shardHash(42), shardHash(uint8(42)))
+		assert.Equal(t, shardHash(42), shardHash(uint32(42)))
+		assert.Equal(t, shardHash(42), shardHash(int16(42)))
+	})
+
+	t.Run("named_types", func(t *testing.T) {
+		type userID int32
+		type accountID uint64
+		assert.Equal(t, shardHash("tenant-a"), shardHash(testTenantID("tenant-a")))
+		assert.Equal(t, shardHash(int32(-7)), shardHash(userID(-7)))
+		assert.Equal(t, shardHash(uint64(7)), shardHash(accountID(7)))
+	})
+}
+
+func TestSliceShardBy(t *testing.T) {
+	t.Parallel()
+
+	tenantKey := func(r testRecord) string { return r.tenant }
+	records := make([]testRecord, 200)
+	for i := range records {
+		records[i] = testRecord{tenant: "tenant-" + strconv.Itoa(i%20), value: i}
+	}
+
+	t.Run("same_key_same_shard", func(t *testing.T) {
+		shards := SliceShardBy(4, tenantKey, records[:100], records[100:])
+		require.Len(t, shards, 4)
+		var total int
+		for shardIdx, shard := range shards {
+			total += len(shard)
+			for _, r := range shard {
+				assert.Equal(t, int(shardHash(r.tenant)%4), shardIdx)
+			}
+			for i := 1; i < len(shard); i++ {
+				assert.Less(t, shard[i-1].value, shard[i].value) // order preserved
+			}
+		}
+		assert.Equal(t, len(records), total)
+	})
+
+	t.Run("into", func(t *testing.T) {
+		dest := make([][]testRecord, 4)
+		dest[0] = []testRecord{{tenant: "existing"}}
+		SliceShardByInto(dest, tenantKey, records)
+		expected := SliceShardBy(4, tenantKey, records)
+		assert.Equal(t, append([]testRecord{{tenant: "existing"}}, expected[0]...), dest[0])
+		assert.Equal(t, expected[1:], dest[1:])
+	})
+
+	t.Run("integer_keys", func(t *testing.T) {
+		ids := []int64{1, 2, 3, 4, 5, 6, 7, 8}
+		shards := SliceShardBy(3, func(v int64) int64 { return v }, ids)
+		for shardIdx, shard := range shards {
+			for _, id := range shard {
+				assert.Equal(t, int(shardHash(id)%3), shardIdx)
+			}
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, [][]testRecord{nil, nil}, SliceShardBy(2, tenantKey))
+	})
+
+	t.Run("invalid_count", func(t *testing.T) {
+		assert.PanicsWithValue(t, "bulk: shard count 0 must be positive", func() {
+			SliceShardBy(0, tenantKey, records)
+		})
+		assert.PanicsWithValue(t, "bulk: shard count -1 must be positive", func() {
+			SliceShardBy(-1, tenantKey)
+		})
+		assert.PanicsWithValue(t, "bulk: shard count 0 must be positive", func() {
+			SliceShardByInto(nil, tenantKey, records)
+		})
+	})
+}
+
+func TestSliceShardConsistentBy(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]int, 10000)
+	for i := range keys {
+		keys[i] = i
+	}
+	identity := func(v int) int { return v }
+
+	t.Run("minimal_movement", func(t *testing.T) {
+		for n := 1; n < 10; n++ {
+			before := SliceShardConsistentBy(n, identity, keys)
+			after := SliceShardConsistentBy(n+1, identity, keys)
+			require.Len(t, after, n+1)
+
+			var moved int
+			for shardIdx := 0; shardIdx < n; shardIdx++ {
+				remaining := SliceToSet(after[shardIdx])
+				for _, key := range before[shardIdx] {
+					if _, ok := remaining[key]; !ok {
+						moved++
+					}
+				}
+				// keys can only move out of existing shards, never between them
+				assert.Empty(t, SliceDifference(after[shardIdx], before[shardIdx]))
+			}
+			assert.Equal(t, moved, len(after[n]))
+			expectedMoved := len(keys) / (n + 1)
+			assert.InDelta(t, expectedMoved, moved, float64(expectedMoved)*0.2)
+		}
+	})
+
+	t.Run("into", func(t *testing.T) {
+		dest := make([][]int, 5)
+		SliceShardConsistentByInto(dest, identity, keys[:5000], keys[5000:])
+		assert.Equal(t, SliceShardConsistentBy(5, identity, keys), dest)
+	})
+
+	t.Run("single_shard", func(t *testing.T) {
+		shards := SliceShardConsistentBy(1, identity, keys)
+		assert.Equal(t, keys, shards[0])
+	})
+
+	t.Run("invalid_count", func(t *testing.T) {
+		assert.PanicsWithValue(t, "bulk: shard count 0 must be positive", func() {
+			SliceShardConsistentBy(0, identity, keys)
+		})
+		assert.PanicsWithValue(t, "bulk: shard count 0 must be positive", func() {
+			SliceShardConsistentByInto([][]int{}, identity, keys)
+		})
+	})
+}
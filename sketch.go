@@ -0,0 +1,298 @@
+package bulk
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// HyperLogLog estimates the number of distinct keys added using a fixed amount of memory, as a memory-bounded
+// alternative to SliceToSet when only the count is needed. Keys are hashed consistently across processes (see
+// SliceShardBy), so sketches built in separate batches or processes can be merged.
+type HyperLogLog[K shardKey] struct {
+	precision uint8
+	registers []uint8
+}
+
+// NewHyperLogLog returns an empty HyperLogLog using 2^precision single byte registers. The standard error of the
+// estimate is about 1.04/sqrt(2^precision), for example precision 14 uses 16KiB with an error of about 0.8%.
+// Panics if precision is outside [4, 18].
+func NewHyperLogLog[K shardKey](precision int) *HyperLogLog[K] {
+	if precision < 4 || precision > 18 {
+		panic("bulk: HyperLogLog precision must be within [4, 18]")
+	}
+	return &HyperLogLog[K]{precision: uint8(precision), registers: make([]uint8, 1<<precision)}
+}
+
+// Add records the key.
+func (h *HyperLogLog[K]) Add(key K) {
+	hash := shardHash(key)
+	idx := hash >> (64 - h.precision)
+	// sentinel bit bounds the rank if the remaining bits are all zero
+	rank := uint8(bits.LeadingZeros64(hash<<h.precision|1<<(h.precision-1))) + 1
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+// AddSlices records every element of the slices.
+func (h *HyperLogLog[K]) AddSlices(slices ...[]K) {
+	for _, slice := range slices {
+		for _, key := range slice {
+			h.Add(key)
+		}
+	}
+}
+
+// Estimate returns the approximate number of distinct keys added.
+func (h *HyperLogLog[K]) Estimate() uint64 {
+	m := float64(len(h.registers))
+	var sum float64
+	var zeros int
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 { // small range correction using linear counting
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+// SliceIntoHyperLogLogBy records the key generated by keyfunc for each element of the slices into the HyperLogLog.
+func SliceIntoHyperLogLogBy[T any, K shardKey](h *HyperLogLog[K], keyfunc func(T) K, slices ...[]T) {
+	for _, slice := range slices {
+		for _, val := range slice {
+			h.Add(keyfunc(val))
+		}
+	}
+}
+
+// Merge combines the other sketch into this one, so the estimate reflects the union of keys added to both.
+// Panics if the sketches have different precisions.
+func (h *HyperLogLog[K]) Merge(other *HyperLogLog[K]) {
+	if h.precision != other.precision {
+		panic("bulk: HyperLogLog precision mismatch")
+	}
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+}
+
+// HeavyHitter is a key and its estimated count reported by CountMinSketch.HeavyHitters.
+type HeavyHitter[K shardKey] struct {
+	Key   K
+	Count uint64
+}
+
+// CountMinSketch estimates the number of times each key was added using a fixed amount of memory, as a
+// memory-bounded alternative to SliceToCounts. Estimates never undercount, and overcount by at most epsilon times the
+// total count with probability 1-delta. Optionally the most frequent keys are tracked for HeavyHitters. Keys are
+// hashed consistently across processes, so sketches built in separate batches or processes can be merged.
+type CountMinSketch[K shardKey] struct {
+	width    uint64
+	depth    int
+	counters []uint64
+	total    uint64
+	topK     int
+	heavy    []HeavyHitter[K] // min-heap by Count
+	heavyIdx map[K]int        // key -> position within heavy
+}
+
+// NewCountMinSketch returns an empty CountMinSketch sized for the provided error bounds, using
+// ceil(e/epsilon) * ceil(ln(1/delta)) counters. If topK is positive, the topK most frequent keys are tracked for
+// HeavyHitters. Panics if epsilon or delta are outside (0, 1).
+func NewCountMinSketch[K shardKey](epsilon, delta float64, topK int) *CountMinSketch[K] {
+	if !(epsilon > 0 && epsilon < 1) || !(delta > 0 && delta < 1) {
+		panic("bulk: CountMinSketch epsilon and delta must be within (0, 1)")
+	}
+	width := uint64(math.Ceil(math.E / epsilon))
+	depth := int(math.Ceil(math.Log(1 / delta)))
+	s := &CountMinSketch[K]{
+		width:    width,
+		depth:    depth,
+		counters: make([]uint64, width*uint64(depth)),
+		topK:     topK,
+	}
+	if topK > 0 {
+		s.heavy = make([]HeavyHitter[K], 0, topK)
+		s.heavyIdx = make(map[K]int, topK)
+	}
+	return s
+}
+
+// cell returns the counter index for the key within the row, using double hashing of the key hash.
+func (s *CountMinSketch[K]) cell(hash uint64, row int) uint64 {
+	h2 := mixHash(hash) | 1
+	return uint64(row)*s.width + (hash+uint64(row)*h2)%s.width
+}
+
+// Add records a single occurrence of the key.
+func (s *CountMinSketch[K]) Add(key K) {
+	s.AddCount(key, 1)
+}
+
+// AddCount records count occurrences of the key.
+func (s *CountMinSketch[K]) AddCount(key K, count uint64) {
+	hash := shardHash(key)
+	estimate := uint64(math.MaxUint64)
+	for row := 0; row < s.depth; row++ {
+		c := s.cell(hash, row)
+		s.counters[c] += count
+		if s.counters[c] < estimate {
+			estimate = s.counters[c]
+		}
+	}
+	s.total += count
+	if s.topK > 0 {
+		s.trackHeavy(key, estimate)
+	}
+}
+
+// AddSlices records every element of the slices.
+func (s *CountMinSketch[K]) AddSlices(slices ...[]K) {
+	for _, slice := range slices {
+		for _, key := range slice {
+			s.Add(key)
+		}
+	}
+}
+
+// SliceIntoCountMinSketchBy records the key generated by keyfunc for each element of the slices into the
+// CountMinSketch.
+func SliceIntoCountMinSketchBy[T any, K shardKey](s *CountMinSketch[K], keyfunc func(T) K, slices ...[]T) {
+	for _, slice := range slices {
+		for _, val := range slice {
+			s.Add(keyfunc(val))
+		}
+	}
+}
+
+// Count returns the estimated number of times the key was added. The estimate is never less than the true count.
+func (s *CountMinSketch[K]) Count(key K) uint64 {
+	hash := shardHash(key)
+	estimate := uint64(math.MaxUint64)
+	for row := 0; row < s.depth; row++ {
+		if c := s.counters[s.cell(hash, row)]; c < estimate {
+			estimate = c
+		}
+	}
+	return estimate
+}
+
+// Total returns the total count of all keys added.
+func (s *CountMinSketch[K]) Total() uint64 {
+	return s.total
+}
+
+// Merge combines the other sketch into this one, so counts reflect the keys added to both. Tracked heavy hitters are
+// re-evaluated against the merged counts. Panics if the sketches have different dimensions.
+func (s *CountMinSketch[K]) Merge(other *CountMinSketch[K]) {
+	if s.width != other.width || s.depth != other.depth {
+		panic("bulk: CountMinSketch dimension mismatch")
+	}
+	for i, c := range other.counters {
+		s.counters[i] += c
+	}
+	s.total += other.total
+	if s.topK == 0 {
+		return
+	}
+
+	candidates := make([]K, 0, len(s.heavy)+len(other.heavy))
+	for _, hh := range s.heavy {
+		candidates = append(candidates, hh.Key)
+	}
+	for _, hh := range other.heavy {
+		candidates = append(candidates, hh.Key)
+	}
+	s.heavy = s.heavy[:0]
+	for k := range s.heavyIdx {
+		delete(s.heavyIdx, k)
+	}
+	for _, key := range candidates {
+		s.trackHeavy(key, s.Count(key))
+	}
+}
+
+// HeavyHitters returns the tracked most frequent keys with an estimated count of at least fraction of the total
+// count, ordered by descending count. Returns nil if the sketch was created without topK tracking.
+func (s *CountMinSketch[K]) HeavyHitters(fraction float64) []HeavyHitter[K] {
+	threshold := fraction * float64(s.total)
+	var result []HeavyHitter[K]
+	for _, hh := range s.heavy {
+		if float64(hh.Count) >= threshold {
+			result = append(result, hh)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Count > result[j].Count })
+	return result
+}
+
+// trackHeavy updates the bounded min-heap of most frequent keys with the latest estimate for the key.
+func (s *CountMinSketch[K]) trackHeavy(key K, estimate uint64) {
+	if i, ok := s.heavyIdx[key]; ok {
+		s.heavy[i].Count = estimate // estimates only grow, so the entry can only move down
+		s.heavyDown(i)
+		return
+	} else if len(s.heavy) < s.topK {
+		s.heavy = append(s.heavy, HeavyHitter[K]{Key: key, Count: estimate})
+		i = len(s.heavy) - 1
+		s.heavyIdx[key] = i
+		for i > 0 { // sift up
+			parent := (i - 1) / 2
+			if s.heavy[parent].Count <= s.heavy[i].Count {
+				break
+			}
+			s.heavySwap(i, parent)
+			i = parent
+		}
+		return
+	} else if estimate <= s.heavy[0].Count {
+		return
+	}
+	delete(s.heavyIdx, s.heavy[0].Key)
+	s.heavy[0] = HeavyHitter[K]{Key: key, Count: estimate}
+	s.heavyIdx[key] = 0
+	s.heavyDown(0)
+}
+
+func (s *CountMinSketch[K]) heavyDown(i int) {
+	for {
+		smallest := i
+		if l := 2*i + 1; l < len(s.heavy) && s.heavy[l].Count < s.heavy[smallest].Count {
+			smallest = l
+		}
+		if r := 2*i + 2; r < len(s.heavy) && s.heavy[r].Count < s.heavy[smallest].Count {
+			smallest = r
+		}
+		if smallest == i {
+			return
+		}
+		s.heavySwap(i, smallest)
+		i = smallest
+	}
+}
+
+func (s *CountMinSketch[K]) heavySwap(i, j int) {
+	s.heavy[i], s.heavy[j] = s.heavy[j], s.heavy[i]
+	s.heavyIdx[s.heavy[i].Key] = i
+	s.heavyIdx[s.heavy[j].Key] = j
+}
@@ -0,0 +1,151 @@
+package bulk
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, uint64(0), NewHyperLogLog[int](10).Estimate())
+	})
+	t.Run("small_exact", func(t *testing.T) {
+		h := NewHyperLogLog[string](14)
+		h.AddSlices([]string{"a", "b", "c"}, []string{"a", "b", "c", "d"})
+
+		assert.Equal(t, uint64(4), h.Estimate())
+	})
+	for _, n := range []int{1_000, 100_000} {
+		for _, precision := range []int{10, 14} {
+			t.Run(strconv.Itoa(n)+"-"+strconv.Itoa(precision), func(t *testing.T) {
+				h := NewHyperLogLog[uint32](precision)
+				values := make([]uint32, n)
+				for i := range values {
+					values[i] = uint32(i * 7)
+				}
+				h.AddSlices(values, values) // duplicates must not affect the estimate
+
+				stdErr := 1.04 / float64(int(1)<<(precision/2))
+				assert.InEpsilon(t, n, h.Estimate(), 4*stdErr)
+			})
+		}
+	}
+	t.Run("merge", func(t *testing.T) {
+		a := NewHyperLogLog[int](12)
+		b := NewHyperLogLog[int](12)
+		union := NewHyperLogLog[int](12)
+		for i := 0; i < 20_000; i++ {
+			a.Add(i)
+			b.Add(i + 10_000)
+			union.Add(i)
+			union.Add(i + 10_000)
+		}
+		a.Merge(b)
+
+		assert.Equal(t, union.Estimate(), a.Estimate())
+		assert.InEpsilon(t, 30_000, a.Estimate(), 0.1)
+	})
+	t.Run("add_slices_by", func(t *testing.T) {
+		type user struct{ id string }
+		h := NewHyperLogLog[string](14)
+		SliceIntoHyperLogLogBy(h, func(u user) string { return u.id }, []user{{"a"}, {"b"}, {"a"}})
+
+		assert.Equal(t, uint64(2), h.Estimate())
+	})
+	t.Run("panics", func(t *testing.T) {
+		assert.Panics(t, func() { NewHyperLogLog[int](3) })
+		assert.Panics(t, func() { NewHyperLogLog[int](19) })
+		assert.Panics(t, func() { NewHyperLogLog[int](10).Merge(NewHyperLogLog[int](11)) })
+	})
+}
+
+func TestCountMinSketch(t *testing.T) {
+	t.Parallel()
+
+	// zipf distributed keys, where a few keys dominate the counts
+	rng := rand.New(rand.NewSource(42))
+	zipf := rand.NewZipf(rng, 1.5, 1, 10_000)
+	values := make([]uint64, 200_000)
+	for i := range values {
+		values[i] = zipf.Uint64()
+	}
+	exact := SliceToCounts(values)
+
+	t.Run("counts", func(t *testing.T) {
+		const epsilon = 0.001
+		s := NewCountMinSketch[uint64](epsilon, 0.01, 0)
+		s.AddSlices(values[:100_000], values[100_000:])
+
+		require.Equal(t, uint64(len(values)), s.Total())
+		var outside int
+		for key, count := range exact {
+			estimate := s.Count(key)
+			assert.GreaterOrEqual(t, estimate, uint64(count))
+			if float64(estimate-uint64(count)) > epsilon*float64(len(values)) {
+				outside++
+			}
+		}
+		assert.LessOrEqual(t, outside, len(exact)/100)
+		assert.LessOrEqual(t, float64(s.Count(1_000_000)), epsilon*float64(len(values))) // never added
+		assert.Nil(t, s.HeavyHitters(0.01))
+	})
+	t.Run("heavy_hitters", func(t *testing.T) {
+		s := NewCountMinSketch[uint64](0.001, 0.01, 20)
+		s.AddSlices(values)
+		hitters := s.HeavyHitters(0.01)
+
+		var expected []uint64
+		for key, count := range exact {
+			if float64(count) >= 0.01*float64(len(values)) {
+				expected = append(expected, key)
+			}
+		}
+		require.NotEmpty(t, expected)
+		assert.ElementsMatch(t, expected, SliceTransform(func(hh HeavyHitter[uint64]) uint64 { return hh.Key }, hitters))
+		for i, hh := range hitters {
+			assert.GreaterOrEqual(t, hh.Count, uint64(exact[hh.Key]))
+			if i > 0 {
+				assert.LessOrEqual(t, hh.Count, hitters[i-1].Count)
+			}
+		}
+	})
+	t.Run("merge", func(t *testing.T) {
+		a := NewCountMinSketch[uint64](0.001, 0.01, 5)
+		b := NewCountMinSketch[uint64](0.001, 0.01, 5)
+		whole := NewCountMinSketch[uint64](0.001, 0.01, 5)
+		a.AddSlices(values[:50_000])
+		b.AddSlices(values[50_000:])
+		whole.AddSlices(values)
+		a.Merge(b)
+
+		assert.Equal(t, whole.Total(), a.Total())
+		for key := range exact {
+			assert.Equal(t, whole.Count(key), a.Count(key))
+		}
+		assert.Equal(t, whole.HeavyHitters(0), a.HeavyHitters(0))
+	})
+	t.Run("add_slices_by", func(t *testing.T) {
+		type event struct{ user string }
+		s := NewCountMinSketch[string](0.01, 0.01, 2)
+		SliceIntoCountMinSketchBy(s, func(e event) string { return e.user },
+			[]event{{"a"}, {"b"}, {"a"}}, []event{{"c"}, {"a"}, {"b"}})
+		s.AddCount("d", 2)
+
+		assert.Equal(t, uint64(3), s.Count("a"))
+		assert.Equal(t, uint64(2), s.Count("b"))
+		assert.Equal(t, uint64(8), s.Total())
+		assert.Len(t, s.HeavyHitters(0), 2)
+		assert.Equal(t, HeavyHitter[string]{Key: "a", Count: 3}, s.HeavyHitters(0)[0])
+	})
+	t.Run("panics", func(t *testing.T) {
+		assert.Panics(t, func() { NewCountMinSketch[int](0, 0.1, 0) })
+		assert.Panics(t, func() { NewCountMinSketch[int](0.1, 1, 0) })
+		assert.Panics(t, func() { NewCountMinSketch[int](0.1, 0.1, 0).Merge(NewCountMinSketch[int](0.01, 0.1, 0)) })
+	})
+}
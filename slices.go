@@ -1,5 +1,11 @@
 package bulk
 
+import (
+	"fmt"
+	"runtime/debug"
+	"sort"
+)
+
 // SliceFilter returns elements that pass the predicate function.
 // May return the original slice if all elements pass (no allocation).
 func SliceFilter[T any](predicate func(val T) bool, slices ...[]T) []T {
@@ -36,8 +42,43 @@ func SliceFilter[T any](predicate func(val T) bool, slices ...[]T) []T {
 	return sliceConcat(results, concatInPlace)
 }
 
+// SliceFilterCopy returns elements that pass the predicate function.
+// Unlike SliceFilter, the result is always a new allocation sized exactly to the result and never shares memory with
+// the inputs, so it is safe to append to or modify.
+func SliceFilterCopy[T any](predicate func(val T) bool, slices ...[]T) []T {
+	switch len(slices) {
+	case 0:
+		return nil
+	case 1:
+		return sliceOwnedCopy(singleSliceFilter(predicate, slices[0]))
+	}
+
+	// results are combined directly into the exact allocation rather than through the concat of SliceFilter
+	results := make([][]T, 0, len(slices))
+	var lastView bool
+	for _, slice := range slices {
+		if result, view := singleSliceFilter(predicate, slice); len(result) > 0 {
+			results = append(results, result)
+			lastView = view
+		}
+	}
+	return sliceConcatOwned(results, lastView)
+}
+
+// SliceFilterIndexed returns elements that pass the predicate function.
+// The predicate receives the index of the input slice and the element's index within that slice.
+// Performs the same view optimizations as SliceFilter, and may return the original slice if all elements pass.
+func SliceFilterIndexed[T any](predicate func(sliceIdx, elemIdx int, val T) bool, slices ...[]T) []T {
+	idx := sliceIndexTracker[T]{slices: slices}
+	return SliceFilter(func(val T) bool {
+		sliceIdx, elemIdx := idx.next()
+		return predicate(sliceIdx, elemIdx, val)
+	}, slices...)
+}
+
 // singleSliceFilter filters a single slice based on the predicate function.
 // Returns (filteredElements, isView) where isView indicates if the result is a view of the original slice.
+// The predicate is invoked exactly once per element in index order, which the Indexed variants depend on.
 func singleSliceFilter[T any](predicate func(val T) bool, slice []T) ([]T, bool) {
 	for falseIdx, val := range slice {
 		if predicate(val) {
@@ -171,19 +212,24 @@ func SliceFilterTransform[I any, R any](predicate func(I) bool, transform func(I
 
 // SliceFilterTransformErr returns transformed elements that pass the predicate function.
 // Combines filtering and transformation in a single efficient pass.
-// If the conversion function returns an error, appending will stop with the partial result returned and the original error.
+// If the conversion function returns an error, appending will stop with the partial result returned and an
+// *ElementError wrapping the original error with the position of the failing element.
 func SliceFilterTransformErr[I any, R any](predicate func(I) bool, transform func(I) (R, error), inputs ...[]I) ([]R, error) {
 	switch len(inputs) {
 	case 0:
 		return nil, nil
 	case 1:
-		return singleSliceFilterTransform(predicate, transform, inputs[0])
+		result, errIdx, err := singleSliceFilterTransform(predicate, transform, inputs[0])
+		if err != nil {
+			return result, &ElementError{SliceIndex: 0, ElementIndex: errIdx, Err: err}
+		}
+		return result, nil
 	}
 
 	var results [][]R
 	for i, slice := range inputs {
 		// simpler because empty results will never have capacity, so we only have to care about parts with results
-		partResult, err := singleSliceFilterTransform(predicate, transform, slice)
+		partResult, errIdx, err := singleSliceFilterTransform(predicate, transform, slice)
 		if len(partResult) > 0 {
 			if results == nil {
 				results = make([][]R, 0, len(inputs)-i)
@@ -191,14 +237,15 @@ func SliceFilterTransformErr[I any, R any](predicate func(I) bool, transform fun
 			results = append(results, partResult)
 		}
 		if err != nil {
-			return sliceConcat(results, true), err
+			return sliceConcat(results, true), &ElementError{SliceIndex: i, ElementIndex: errIdx, Err: err}
 		}
 	}
 	return sliceConcat(results, true), nil
 }
 
 // singleSliceFilterTransform filters and transforms a single slice based on the predicate and transform functions.
-func singleSliceFilterTransform[I any, R any](predicate func(I) bool, transform func(I) (R, error), slice []I) ([]R, error) {
+// Returns (transformedElements, errIdx, err) where errIdx is the index of the element which failed to transform.
+func singleSliceFilterTransform[I any, R any](predicate func(I) bool, transform func(I) (R, error), slice []I) ([]R, int, error) {
 	for falseIdx, val := range slice {
 		if predicate(val) {
 			continue // continue till first false is found
@@ -217,7 +264,7 @@ func singleSliceFilterTransform[I any, R any](predicate func(I) bool, transform
 				}
 			}
 			if firstTrueIdx == -1 {
-				return nil, nil // No true elements found, return empty slice
+				return nil, -1, nil // No true elements found, return empty slice
 			}
 
 			// Check if all remaining elements are consecutive and true
@@ -234,7 +281,8 @@ func singleSliceFilterTransform[I any, R any](predicate func(I) bool, transform
 			}
 			if nonConsecutiveStart < 0 {
 				// All elements from firstTrueIdx to end are true - transform consecutive section
-				return SliceTransformErr(transform, slice[firstTrueIdx:])
+				result := make([]R, 0, capGuess(len(slice)-firstTrueIdx))
+				return sliceTransformFrom(result, transform, slice, firstTrueIdx)
 			}
 
 			// if any more trues, we have to allocate and append, otherwise return transformed slice
@@ -246,22 +294,23 @@ func singleSliceFilterTransform[I any, R any](predicate func(I) bool, transform
 					for i := firstTrueIdx; i <= consecutiveEnd; i++ {
 						val, err := transform(slice[i])
 						if err != nil {
-							return result, err
+							return result, i, err
 						}
 						result = append(result, val)
 					}
 					val, err := transform(slice[j])
 					if err != nil {
-						return result, err
+						return result, j, err
 					}
 					result = append(result, val)
 
 					// Continue appending remaining true elements
-					return SliceFilterTransformErrInto(result, predicate, transform, slice[j+1:])
+					return sliceFilterTransformFrom(result, predicate, transform, slice, j+1)
 				}
 			}
 			// No more true elements found, return consecutive transformed slice
-			return SliceTransformErr(transform, slice[firstTrueIdx:consecutiveEnd+1])
+			result := make([]R, 0, capGuess(consecutiveEnd+1-firstTrueIdx))
+			return sliceTransformFrom(result, transform, slice[:consecutiveEnd+1], firstTrueIdx)
 		} else { // Started true, now first false found
 			// Find first true element after falseIdx
 			secondTrueIdx := -1
@@ -273,7 +322,7 @@ func singleSliceFilterTransform[I any, R any](predicate func(I) bool, transform
 			}
 			if secondTrueIdx < 0 {
 				// No true elements in suffix, return transformed prefix only
-				return SliceTransformErr(transform, slice[:falseIdx])
+				return sliceTransformFrom(make([]R, 0, capGuess(falseIdx)), transform, slice[:falseIdx], 0)
 			}
 
 			// true+ -> false+ -> true - We must allocate at this point
@@ -281,20 +330,48 @@ func singleSliceFilterTransform[I any, R any](predicate func(I) bool, transform
 			for i := 0; i < falseIdx; i++ {
 				val, err := transform(slice[i])
 				if err != nil {
-					return result, err
+					return result, i, err
 				}
 				result = append(result, val)
 			}
 			val, err := transform(slice[secondTrueIdx])
 			if err != nil {
-				return result, err
+				return result, secondTrueIdx, err
 			}
 			result = append(result, val)
-			return SliceFilterTransformErrInto(result, predicate, transform, slice[secondTrueIdx+1:])
+			return sliceFilterTransformFrom(result, predicate, transform, slice, secondTrueIdx+1)
 		}
 	}
 	// all records tested to true - transform all elements
-	return SliceTransformErr(transform, slice)
+	return sliceTransformFrom(make([]R, 0, capGuess(len(slice))), transform, slice, 0)
+}
+
+// sliceTransformFrom appends transformed elements from slice[start:] into dest.
+// Returns (dest, errIdx, err) where errIdx is the index within slice of the element which failed, or -1.
+func sliceTransformFrom[I any, R any](dest []R, transform func(I) (R, error), slice []I, start int) ([]R, int, error) {
+	for i := start; i < len(slice); i++ {
+		finalVal, err := transform(slice[i])
+		if err != nil {
+			return dest, i, err
+		}
+		dest = append(dest, finalVal)
+	}
+	return dest, -1, nil
+}
+
+// sliceFilterTransformFrom appends transformed elements from slice[start:] that pass the predicate function into dest.
+// Returns (dest, errIdx, err) where errIdx is the index within slice of the element which failed, or -1.
+func sliceFilterTransformFrom[I any, R any](dest []R, predicate func(I) bool, transform func(I) (R, error), slice []I, start int) ([]R, int, error) {
+	for i := start; i < len(slice); i++ {
+		if predicate(slice[i]) {
+			finalVal, err := transform(slice[i])
+			if err != nil {
+				return dest, i, err
+			}
+			dest = append(dest, finalVal)
+		}
+	}
+	return dest, -1, nil
 }
 
 // SliceFilterTransformInto appends transformed elements that pass the predicate function from the input slices into dest.
@@ -305,14 +382,45 @@ func SliceFilterTransformInto[I any, R any](dest []R, predicate func(I) bool, tr
 }
 
 // SliceFilterTransformErrInto appends transformed elements that pass the predicate function from the input slices into dest.
-// If the conversion function returns an error, operation stops and returns the partial result with the original error.
+// If the conversion function returns an error, operation stops and returns the partial result with an *ElementError
+// wrapping the original error with the position of the failing element.
 func SliceFilterTransformErrInto[I any, R any](dest []R, predicate func(I) bool, transform func(I) (R, error), inputs ...[]I) ([]R, error) {
-	for _, input := range inputs {
-		for _, val := range input {
-			if predicate(val) {
-				finalVal, err := transform(val)
+	for sliceIdx, input := range inputs {
+		var errIdx int
+		var err error
+		if dest, errIdx, err = sliceFilterTransformFrom(dest, predicate, transform, input, 0); err != nil {
+			return dest, &ElementError{SliceIndex: sliceIdx, ElementIndex: errIdx, Err: err}
+		}
+	}
+	return dest, nil
+}
+
+// SliceFilterTransformErrRecover returns transformed elements that pass the predicate function.
+// Behaves like SliceFilterTransformErr, but a panic in the predicate or transform function is recovered and returned
+// as a *PanicError, wrapped in an *ElementError with the position of the element being processed.
+func SliceFilterTransformErrRecover[I any, R any](predicate func(I) bool, transform func(I) (R, error), inputs ...[]I) ([]R, error) {
+	return SliceFilterTransformErrRecoverInto(nil, predicate, transform, inputs...)
+}
+
+// SliceFilterTransformErrRecoverInto appends transformed elements that pass the predicate function from the input
+// slices into dest. Behaves like SliceFilterTransformErrInto, but a panic in the predicate or transform function is
+// recovered and returned as a *PanicError, wrapped in an *ElementError with the position of the element being processed.
+func SliceFilterTransformErrRecoverInto[I any, R any](dest []R, predicate func(I) bool, transform func(I) (R, error), inputs ...[]I) (result []R, err error) {
+	var sliceIdx, elemIdx int
+	defer func() {
+		if r := recover(); r != nil {
+			result = dest
+			err = &ElementError{SliceIndex: sliceIdx, ElementIndex: elemIdx, Err: &PanicError{Value: r, Stack: debug.Stack()}}
+		}
+	}()
+
+	for sliceIdx = range inputs {
+		input := inputs[sliceIdx]
+		for elemIdx = range input {
+			if predicate(input[elemIdx]) {
+				finalVal, err := transform(input[elemIdx])
 				if err != nil {
-					return dest, err
+					return dest, &ElementError{SliceIndex: sliceIdx, ElementIndex: elemIdx, Err: err}
 				}
 				dest = append(dest, finalVal)
 			}
@@ -321,6 +429,35 @@ func SliceFilterTransformErrInto[I any, R any](dest []R, predicate func(I) bool,
 	return dest, nil
 }
 
+// SliceFilterTransformTry returns transformed elements that pass the predicate function.
+// Unlike SliceFilterTransformErr, elements which fail to transform are skipped and processing continues.
+// If any elements failed, the returned error is an ElementErrors listing the position and cause of each failure.
+func SliceFilterTransformTry[I any, R any](predicate func(I) bool, transform func(I) (R, error), inputs ...[]I) ([]R, error) {
+	var result []R
+	var errs ElementErrors
+	remaining := sliceLen(inputs)
+	for sliceIdx, input := range inputs {
+		for elemIdx, val := range input {
+			remaining--
+			if !predicate(val) {
+				continue
+			}
+			finalVal, err := transform(val)
+			if err != nil {
+				errs = append(errs, &ElementError{SliceIndex: sliceIdx, ElementIndex: elemIdx, Err: err})
+				continue
+			} else if result == nil { // allocate based on potential remaining
+				result = make([]R, 0, capGuess(remaining+1))
+			}
+			result = append(result, finalVal)
+		}
+	}
+	if len(errs) > 0 {
+		return result, errs
+	}
+	return result, nil
+}
+
 // SliceFilterInPlace returns elements that pass the predicate function.
 // The input slice is modified and must be discarded after calling.
 func SliceFilterInPlace[T any](predicate func(val T) bool, slices ...[]T) []T {
@@ -373,6 +510,33 @@ func singleSliceFilterInPlace[T any](predicate func(v T) bool, slice []T) []T {
 	return slice[:n]
 }
 
+// SliceFilterTransformInPlace transforms elements that pass the predicate function, compacting the results into the
+// input slice in a single pass. The input slice is modified and must be discarded after calling.
+func SliceFilterTransformInPlace[T any](predicate func(T) bool, transform func(T) T, slice []T) []T {
+	errTransform := func(v T) (T, error) { return transform(v), nil }
+	result, _ := SliceFilterTransformErrInPlace(predicate, errTransform, slice)
+	return result
+}
+
+// SliceFilterTransformErrInPlace transforms elements that pass the predicate function, compacting the results into
+// the input slice in a single pass. The input slice is modified and must be discarded after calling.
+// If the transform function returns an error, operation stops and returns the partial result with an *ElementError
+// wrapping the original error. Elements from the failing element index onward are left unmodified in the input.
+func SliceFilterTransformErrInPlace[T any](predicate func(T) bool, transform func(T) (T, error), slice []T) ([]T, error) {
+	var n int
+	for i := range slice {
+		if predicate(slice[i]) {
+			val, err := transform(slice[i])
+			if err != nil {
+				return slice[:n], &ElementError{SliceIndex: 0, ElementIndex: i, Err: err}
+			}
+			slice[n] = val
+			n++
+		}
+	}
+	return slice[:n], nil
+}
+
 // SliceSplit partitions elements based on the predicate function.
 // Returns (trueElements, falseElements).
 func SliceSplit[T any](predicate func(val T) bool, slices ...[]T) ([]T, []T) {
@@ -418,8 +582,51 @@ func SliceSplit[T any](predicate func(val T) bool, slices ...[]T) ([]T, []T) {
 	return trueResult, falseResult
 }
 
+// SliceSplitIndexed partitions elements based on the predicate function.
+// The predicate receives the index of the input slice and the element's index within that slice.
+// Performs the same view optimizations as SliceSplit.
+// Returns (trueElements, falseElements).
+func SliceSplitIndexed[T any](predicate func(sliceIdx, elemIdx int, val T) bool, slices ...[]T) ([]T, []T) {
+	idx := sliceIndexTracker[T]{slices: slices}
+	return SliceSplit(func(val T) bool {
+		sliceIdx, elemIdx := idx.next()
+		return predicate(sliceIdx, elemIdx, val)
+	}, slices...)
+}
+
+// SliceSplitCopy partitions elements based on the predicate function.
+// Unlike SliceSplit, both results are always new allocations sized exactly to the result and never share memory with
+// the inputs, so they are safe to append to or modify.
+// Returns (trueElements, falseElements).
+func SliceSplitCopy[T any](predicate func(val T) bool, slices ...[]T) ([]T, []T) {
+	switch len(slices) {
+	case 0:
+		return nil, nil
+	case 1:
+		tSlice, fSlice, tView, fView := singleSliceSplit(predicate, slices[0])
+		return sliceOwnedCopy(tSlice, tView), sliceOwnedCopy(fSlice, fView)
+	}
+
+	// results are combined directly into exact allocations rather than through the concat of SliceSplit
+	trueResults, falseResults := make([][]T, 0, len(slices)), make([][]T, 0, len(slices))
+	var trueView, falseView bool
+	for _, slice := range slices {
+		tSlice, fSlice, tView, fView := singleSliceSplit(predicate, slice)
+		if len(tSlice) > 0 {
+			trueResults = append(trueResults, tSlice)
+			trueView = tView
+		}
+		if len(fSlice) > 0 {
+			falseResults = append(falseResults, fSlice)
+			falseView = fView
+		}
+	}
+	return sliceConcatOwned(trueResults, trueView), sliceConcatOwned(falseResults, falseView)
+}
+
 // singleSliceSplit partitions a single slice based on the predicate function.
 // Returns (trueElements, falseElements, trueIsView, falseIsView).
+// The predicate is invoked exactly once per element in index order, which the Indexed variants depend on.
 func singleSliceSplit[T any](predicate func(val T) bool, slice []T) ([]T, []T, bool, bool) {
 	if len(slice) == 0 {
 		return slice, nil, true, false
@@ -530,17 +737,318 @@ func SliceSplitInPlaceUnstable[T any](predicate func(val T) bool, slice []T) ([]
 	}
 }
 
+// SliceSplit3 partitions elements into three groups based on the compare function, which returns a negative value
+// for elements that are less, zero for equal, and a positive value for greater.
+// May return the original slice as one of the results if all elements fall into the same group (no allocation).
+// Returns (lessElements, equalElements, greaterElements).
+func SliceSplit3[T any](compare func(val T) int, slices ...[]T) ([]T, []T, []T) {
+	buckets := SlicePartitionN(func(val T) int {
+		if c := compare(val); c < 0 {
+			return 0
+		} else if c == 0 {
+			return 1
+		}
+		return 2
+	}, 3, slices...)
+	return buckets[0], buckets[1], buckets[2]
+}
+
+// SlicePartitionN partitions elements into n buckets using the classify function, which must return a bucket index
+// in the range [0, n). Element order is preserved within each bucket.
+// The classify function is invoked once per element, and all buckets share a single exact-size allocation.
+// May return the original slice as a bucket if all elements are classified into the same bucket (no allocation).
+// Empty buckets are nil. Panics if classify returns an index outside the range.
+func SlicePartitionN[T any](classify func(val T) int, n int, slices ...[]T) [][]T {
+	result := make([][]T, n)
+	total := sliceLen(slices)
+	if total == 0 {
+		return result
+	}
+
+	bucketIdxs := make([]int32, total) // retain classification to avoid invoking classify twice
+	counts := make([]int, n)
+	var pos int
+	for _, slice := range slices {
+		for _, val := range slice {
+			bucketIdx := classify(val)
+			if bucketIdx < 0 || bucketIdx >= n {
+				panic(fmt.Sprintf("bulk: classify returned bucket %d outside range [0:%d)", bucketIdx, n))
+			}
+			bucketIdxs[pos] = int32(bucketIdx)
+			counts[bucketIdx]++
+			pos++
+		}
+	}
+	if len(slices) == 1 {
+		for bucketIdx, count := range counts {
+			if count == total {
+				result[bucketIdx] = slices[0] // all in one bucket, return original slice
+				return result
+			}
+		}
+	}
+
+	backing := make([]T, total)
+	var offset int
+	for bucketIdx, count := range counts {
+		if count > 0 { // capacity is limited so appending to one bucket can't overwrite the next
+			result[bucketIdx] = backing[offset:offset:(offset + count)]
+			offset += count
+		}
+	}
+	pos = 0
+	for _, slice := range slices {
+		for _, val := range slice {
+			bucketIdx := bucketIdxs[pos]
+			result[bucketIdx] = append(result[bucketIdx], val)
+			pos++
+		}
+	}
+	return result
+}
+
+// SlicePartitionNInPlaceUnstable partitions elements into n buckets using the classify function, which must return
+// a bucket index in the range [0, n). The input slice is rearranged in a counting sort style so that each bucket is a
+// contiguous view of the input, and must be discarded after calling. Element order within buckets may change.
+// The classify function may be invoked more than once per element, so it must be deterministic.
+// Empty buckets are nil. Panics if classify returns an index outside the range.
+func SlicePartitionNInPlaceUnstable[T any](classify func(val T) int, n int, slice []T) [][]T {
+	result := make([][]T, n)
+	if len(slice) == 0 {
+		return result
+	}
+
+	positions := make([]int, 2*n)
+	next, ends := positions[:n], positions[n:] // next is the next unplaced position within each bucket
+	for _, val := range slice {
+		bucketIdx := classify(val)
+		if bucketIdx < 0 || bucketIdx >= n {
+			panic(fmt.Sprintf("bulk: classify returned bucket %d outside range [0:%d)", bucketIdx, n))
+		}
+		ends[bucketIdx]++
+	}
+	var offset int
+	for bucketIdx, count := range ends {
+		next[bucketIdx] = offset
+		offset += count
+		ends[bucketIdx] = offset
+	}
+
+	for bucketIdx := 0; bucketIdx < n; bucketIdx++ {
+		for next[bucketIdx] < ends[bucketIdx] {
+			i := next[bucketIdx]
+			if target := classify(slice[i]); target == bucketIdx {
+				next[bucketIdx]++
+			} else { // swap into the target bucket, the swapped in element is evaluated next
+				slice[i], slice[next[target]] = slice[next[target]], slice[i]
+				next[target]++
+			}
+		}
+	}
+
+	var start int
+	for bucketIdx, end := range ends {
+		if end > start {
+			result[bucketIdx] = slice[start:end:end]
+		}
+		start = end
+	}
+	return result
+}
+
 // SliceTransform converts each element using the conversion function.
 func SliceTransform[I any, R any](conversion func(I) R, inputs ...[]I) []R {
 	result := make([]R, 0, sliceTotalSize(inputs))
 	return SliceFilterTransformInto(result, func(_ I) bool { return true }, conversion, inputs...)
 }
 
+// SliceTransformIndexed converts each element using the conversion function.
+// The conversion function receives the index of the input slice and the element's index within that slice.
+func SliceTransformIndexed[I any, R any](conversion func(sliceIdx, elemIdx int, val I) R, inputs ...[]I) []R {
+	idx := sliceIndexTracker[I]{slices: inputs}
+	return SliceTransform(func(val I) R {
+		sliceIdx, elemIdx := idx.next()
+		return conversion(sliceIdx, elemIdx, val)
+	}, inputs...)
+}
+
 // SliceTransformErr converts each element using the conversion function.
-// If the conversion function returns an error, operation stops and returns the partial result with the original error.
+// If the conversion function returns an error, operation stops and returns the partial result with an *ElementError
+// wrapping the original error with the position of the failing element.
 func SliceTransformErr[I any, R any](conversion func(I) (R, error), inputs ...[]I) ([]R, error) {
 	result := make([]R, 0, sliceTotalSize(inputs))
-	return SliceFilterTransformErrInto(result, func(_ I) bool { return true }, conversion, inputs...)
+	for sliceIdx, input := range inputs {
+		var errIdx int
+		var err error
+		if result, errIdx, err = sliceTransformFrom(result, conversion, input, 0); err != nil {
+			return result, &ElementError{SliceIndex: sliceIdx, ElementIndex: errIdx, Err: err}
+		}
+	}
+	return result, nil
+}
+
+// SliceTransformErrRecover converts each element using the conversion function.
+// Behaves like SliceTransformErr, but a panic in the conversion function is recovered and returned as a *PanicError,
+// wrapped in an *ElementError with the position of the element being converted.
+func SliceTransformErrRecover[I any, R any](conversion func(I) (R, error), inputs ...[]I) ([]R, error) {
+	result := make([]R, 0, sliceTotalSize(inputs))
+	return SliceFilterTransformErrRecoverInto(result, func(_ I) bool { return true }, conversion, inputs...)
+}
+
+// SliceTransformInPlace converts each element using the conversion function, storing the results in the input slice.
+// Avoids allocating a new slice when the input and output types match. The input slice is modified.
+func SliceTransformInPlace[T any](conversion func(T) T, slice []T) []T {
+	for i := range slice {
+		slice[i] = conversion(slice[i])
+	}
+	return slice
+}
+
+// SliceTransformErrInPlace converts each element using the conversion function, storing the results in the input slice.
+// If the conversion function returns an error, operation stops and returns the converted prefix with an *ElementError
+// wrapping the original error. Elements from the failing element index onward are left unmodified.
+func SliceTransformErrInPlace[T any](conversion func(T) (T, error), slice []T) ([]T, error) {
+	for i := range slice {
+		val, err := conversion(slice[i])
+		if err != nil {
+			return slice[:i], &ElementError{SliceIndex: 0, ElementIndex: i, Err: err}
+		}
+		slice[i] = val
+	}
+	return slice, nil
+}
+
+// SliceTransformTry converts each element using the conversion function.
+// Unlike SliceTransformErr, elements which fail to convert are skipped and processing continues.
+// If any elements failed, the returned error is an ElementErrors listing the position and cause of each failure.
+func SliceTransformTry[I any, R any](conversion func(I) (R, error), inputs ...[]I) ([]R, error) {
+	return SliceFilterTransformTry(func(_ I) bool { return true }, conversion, inputs...)
+}
+
+// SliceAny returns true if any element passes the predicate function, stopping at the first passing element.
+func SliceAny[T any](predicate func(val T) bool, slices ...[]T) bool {
+	return SliceFindIndex(predicate, slices...) >= 0
+}
+
+// SliceAll returns true if every element passes the predicate function, stopping at the first failing element.
+// Returns true if there are no elements.
+func SliceAll[T any](predicate func(val T) bool, slices ...[]T) bool {
+	for _, slice := range slices {
+		for _, val := range slice {
+			if !predicate(val) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// SliceNone returns true if no element passes the predicate function, stopping at the first passing element.
+// Returns true if there are no elements.
+func SliceNone[T any](predicate func(val T) bool, slices ...[]T) bool {
+	return SliceFindIndex(predicate, slices...) < 0
+}
+
+// SliceFind returns the first element that passes the predicate function.
+// Returns (element, true) if found, otherwise (zero value, false).
+func SliceFind[T any](predicate func(val T) bool, slices ...[]T) (T, bool) {
+	for _, slice := range slices {
+		for _, val := range slice {
+			if predicate(val) {
+				return val, true
+			}
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// SliceFindIndex returns the index of the first element that passes the predicate function, or -1 if none pass.
+// When multiple slices are provided, the index refers to positions across the slices as if they were concatenated.
+func SliceFindIndex[T any](predicate func(val T) bool, slices ...[]T) int {
+	var offset int
+	for _, slice := range slices {
+		for i, val := range slice {
+			if predicate(val) {
+				return offset + i
+			}
+		}
+		offset += len(slice)
+	}
+	return -1
+}
+
+// SliceFindLast returns the last element that passes the predicate function, searching from the end.
+// Returns (element, true) if found, otherwise (zero value, false).
+func SliceFindLast[T any](predicate func(val T) bool, slices ...[]T) (T, bool) {
+	for sliceIdx := len(slices) - 1; sliceIdx >= 0; sliceIdx-- {
+		slice := slices[sliceIdx]
+		for i := len(slice) - 1; i >= 0; i-- {
+			if predicate(slice[i]) {
+				return slice[i], true
+			}
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// SliceCount returns the number of elements that pass the predicate function, without allocating.
+func SliceCount[T any](predicate func(val T) bool, slices ...[]T) int {
+	var count int
+	for _, slice := range slices {
+		for _, val := range slice {
+			if predicate(val) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// SliceIndexesWhere returns the indexes of elements that pass the predicate function, in ascending order.
+// The indexes can be used with SliceSelectIndexes to select the same rows from other parallel slices.
+func SliceIndexesWhere[T any](predicate func(val T) bool, slice []T) []int {
+	var result []int
+	for i, val := range slice {
+		if predicate(val) {
+			if result == nil { // allocate based on potential remaining
+				result = make([]int, 0, capGuess(len(slice)-i))
+			}
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// SliceSelectIndexes returns the elements at the provided indexes, in the order of the indexes.
+// When multiple slices are provided, indexes refer to positions across the slices as if they were concatenated.
+// The result is allocated to exactly the number of indexes. Panics if an index is out of range.
+func SliceSelectIndexes[T any](indexes []int, slices ...[]T) []T {
+	if len(indexes) == 0 {
+		return nil
+	}
+	result := make([]T, len(indexes))
+	if len(slices) == 1 {
+		slice := slices[0]
+		for i, idx := range indexes {
+			result[i] = slice[idx]
+		}
+		return result
+	}
+
+	offsets := make([]int, len(slices)+1) // offsets[i] is the starting position of slices[i]
+	for i, slice := range slices {
+		offsets[i+1] = offsets[i] + len(slice)
+	}
+	for i, idx := range indexes {
+		if idx < 0 || idx >= offsets[len(slices)] {
+			panic(fmt.Sprintf("bulk: index %d out of range [0:%d]", idx, offsets[len(slices)]))
+		}
+		sliceIdx := sort.SearchInts(offsets, idx+1) - 1
+		result[i] = slices[sliceIdx][idx-offsets[sliceIdx]]
+	}
+	return result
 }
 
 // SliceToSet accepts slices of comparable types and returns a map with elements as keys.
@@ -680,6 +1188,14 @@ func SliceIntersect[T comparable](a, b []T) []T {
 	return result
 }
 
+// SliceIntersectCopy returns elements that exist in both slices, preserving order from the first slice.
+// Unlike SliceIntersect, the result is always a new allocation sized exactly to the result and never shares memory with
+// the inputs.
+func SliceIntersectCopy[T comparable](a, b []T) []T {
+	result := SliceIntersect(a, b)
+	return sliceOwnedCopy(result, len(result) == 0) // only an empty result may be a view of the inputs
+}
+
 // SliceDifference returns elements that exist in the first slice but not in the second, preserving order from the first slice.
 func SliceDifference[T comparable](a, b []T) []T {
 	if len(a) == 0 {
@@ -705,6 +1221,14 @@ func SliceDifference[T comparable](a, b []T) []T {
 	return result
 }
 
+// SliceDifferenceCopy returns elements that exist in the first slice but not in the second, preserving order from the
+// first slice. Unlike SliceDifference, the result is always a new allocation sized exactly to the result and never
+// shares memory with the inputs.
+func SliceDifferenceCopy[T comparable](a, b []T) []T {
+	result := SliceDifference(a, b)
+	return sliceOwnedCopy(result, len(result) == 0) // only an empty result may be a view of the inputs
+}
+
 // SlicePrepend creates a new slice with elm at the front followed by elements from existing slices.
 // Performs a single allocation sized exactly for the result, avoiding multiple allocations
 // that would occur with append([]T{elm}, existing...).
@@ -717,6 +1241,132 @@ func SlicePrepend[T any](elm T, existing ...[]T) []T {
 	return result
 }
 
+// sliceIndexTracker provides the position of each element when elements are visited exactly once in order.
+type sliceIndexTracker[T any] struct {
+	slices   [][]T
+	sliceIdx int
+	elemIdx  int
+}
+
+// next returns the (sliceIdx, elemIdx) of the next element, skipping over empty slices.
+func (t *sliceIndexTracker[T]) next() (int, int) {
+	for t.elemIdx >= len(t.slices[t.sliceIdx]) {
+		t.sliceIdx++
+		t.elemIdx = 0
+	}
+	elemIdx := t.elemIdx
+	t.elemIdx++
+	return t.sliceIdx, elemIdx
+}
+
+// SliceInsertAt creates a new slice with values inserted at index idx, shifting later elements back.
+// Performs a single allocation sized exactly for the result. Panics if idx is outside [0, len(slice)].
+func SliceInsertAt[T any](slice []T, idx int, values ...T) []T {
+	if idx < 0 || idx > len(slice) {
+		panic(fmt.Sprintf("bulk: insert index %d out of range [0:%d]", idx, len(slice)))
+	}
+	result := make([]T, len(slice)+len(values))
+	copy(result, slice[:idx])
+	copy(result[idx:], values)
+	copy(result[idx+len(values):], slice[idx:])
+	return result
+}
+
+// SliceRemoveIndexes returns the elements of the slice excluding those at the provided indexes.
+// Indexes must be strictly ascending. May return a view of the original slice if no indexes are provided, or if the
+// removed indexes form a contiguous prefix or suffix (no allocation). Otherwise a single allocation is performed sized
+// exactly for the result. Panics if an index is out of range or the indexes are not strictly ascending.
+func SliceRemoveIndexes[T any](slice []T, idxs []int) []T {
+	checkRemoveIndexes(idxs, len(slice))
+	switch {
+	case len(idxs) == 0:
+		return slice
+	case idxs[len(idxs)-1] == len(idxs)-1: // removing a prefix
+		return slice[len(idxs):]
+	case idxs[0] == len(slice)-len(idxs) && idxs[len(idxs)-1] == len(slice)-1: // removing a suffix
+		return slice[:idxs[0]]
+	}
+
+	result := make([]T, 0, len(slice)-len(idxs))
+	var start int
+	for _, idx := range idxs {
+		result = append(result, slice[start:idx]...)
+		start = idx + 1
+	}
+	return append(result, slice[start:]...)
+}
+
+// SliceRemoveIndexesInPlace removes the elements at the provided indexes in a single compaction pass.
+// Indexes must be strictly ascending, and no allocation is performed. The input slice is modified and must be
+// discarded after calling. Panics if an index is out of range or the indexes are not strictly ascending.
+func SliceRemoveIndexesInPlace[T any](slice []T, idxs []int) []T {
+	checkRemoveIndexes(idxs, len(slice))
+	if len(idxs) == 0 {
+		return slice
+	}
+
+	n := idxs[0] // elements before the first removed index stay in place
+	for i, idx := range idxs {
+		end := len(slice)
+		if i+1 < len(idxs) {
+			end = idxs[i+1]
+		}
+		n += copy(slice[n:], slice[idx+1:end])
+	}
+	return slice[:n]
+}
+
+// checkRemoveIndexes panics if an index is outside [0, length), or if the indexes are not strictly ascending. Sorting
+// is left to the caller so that removal never needs to allocate a sorted copy of the indexes.
+func checkRemoveIndexes(idxs []int, length int) {
+	for i, idx := range idxs {
+		if idx < 0 || idx >= length {
+			panic(fmt.Sprintf("bulk: index %d out of range [0:%d]", idx, length))
+		} else if i > 0 && idx <= idxs[i-1] {
+			panic(fmt.Sprintf("bulk: index %d does not follow %d in strictly ascending order", idx, idxs[i-1]))
+		}
+	}
+}
+
+// SliceSwapRemove removes the element at idx in O(1) by replacing it with the last element.
+// Element order is not preserved. The input slice is modified and must be discarded after calling.
+// Panics if idx is out of range.
+func SliceSwapRemove[T any](slice []T, idx int) []T {
+	last := len(slice) - 1
+	slice[idx] = slice[last]
+	return slice[:last]
+}
+
+// SliceMove moves the elements in the range [start, end) so that the first moved element is at index to, shifting
+// the elements in between to fill the vacated positions. The slice is reordered in place without allocation.
+// Panics if the range is invalid or if to is outside [0, len(slice)-(end-start)].
+func SliceMove[T any](slice []T, start, end, to int) {
+	if start < 0 || end < start || end > len(slice) {
+		panic(fmt.Sprintf("bulk: move range [%d:%d] out of range [0:%d]", start, end, len(slice)))
+	} else if to < 0 || to > len(slice)-(end-start) {
+		panic(fmt.Sprintf("bulk: move destination %d out of range [0:%d]", to, len(slice)-(end-start)))
+	}
+
+	if to < start { // rotate [to, end) so the range comes first
+		sliceRotateLeft(slice[to:end], start-to)
+	} else if to > start { // rotate [start, to+size) so the range comes last
+		sliceRotateLeft(slice[start:to+(end-start)], end-start)
+	}
+}
+
+// sliceRotateLeft rotates the slice left by k positions in place using three reversals.
+func sliceRotateLeft[T any](slice []T, k int) {
+	sliceReverse(slice[:k])
+	sliceReverse(slice[k:])
+	sliceReverse(slice)
+}
+
+func sliceReverse[T any](slice []T) {
+	for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
+
 // capGuess estimates allocation size, reducing large allocations in case of significant filtering.
 func capGuess(remaining int) int {
 	if remaining > 2048 {
@@ -725,10 +1375,43 @@ func capGuess(remaining int) int {
 	return remaining
 }
 
-func sliceTotalSize[T any](slices [][]T) int {
+// sliceCopy returns a copy of the slice with capacity equal to its length, or nil if the slice is empty.
+func sliceCopy[T any](slice []T) []T {
+	if len(slice) == 0 {
+		return nil
+	}
+	result := make([]T, len(slice))
+	copy(result, slice)
+	return result
+}
+
+// sliceOwnedCopy returns the slice without copying when it is not a view and its capacity equals its length, since it
+// is then already an exact allocation owned by the caller. Otherwise a copy is returned, or nil if the slice is empty.
+func sliceOwnedCopy[T any](slice []T, view bool) []T {
+	if !view && len(slice) > 0 && len(slice) == cap(slice) {
+		return slice
+	}
+	return sliceCopy(slice)
+}
+
+// sliceConcatOwned returns the non-empty results combined into an exact allocation owned by the caller. A single result
+// is returned without copying when it is already such an allocation, as reported by lastView for the last result.
+func sliceConcatOwned[T any](results [][]T, lastView bool) []T {
+	if len(results) == 1 {
+		return sliceOwnedCopy(results[0], lastView)
+	}
+	return sliceConcatCopy(results)
+}
+
+// sliceLen returns the exact combined length of the slices.
+func sliceLen[T any](slices [][]T) int {
 	var size int
 	for _, slice := range slices {
 		size += len(slice)
 	}
-	return capGuess(size)
+	return size
+}
+
+func sliceTotalSize[T any](slices [][]T) int {
+	return capGuess(sliceLen(slices))
 }
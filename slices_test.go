@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -969,6 +970,97 @@ func TestSliceFilter(t *testing.T) {
 	})
 }
 
+func TestSliceFilterCopy(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceTestCases {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			input := sliceDup(tt.input)
+			result := SliceFilterCopy(tt.testFunc, input)
+			if len(tt.expectTrue) == 0 {
+				assert.Nil(t, result)
+			} else {
+				assert.Equal(t, tt.expectTrue, result)
+				assert.Equal(t, len(result), cap(result))
+				result[0] = -100
+				assert.Equal(t, tt.expectTrue, SliceFilter(tt.testFunc, input))
+			}
+		})
+	}
+
+	for i, tt := range sliceMultipleTestCases {
+		t.Run("multi-"+strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			result := SliceFilterCopy(tt.testFunc, tt.slices...)
+			if len(tt.expectTrue) == 0 {
+				assert.Nil(t, result)
+			} else {
+				assert.Equal(t, tt.expectTrue, result)
+				assert.Equal(t, len(result), cap(result))
+				for _, slice := range tt.slices {
+					if len(slice) > 0 {
+						assert.NotSame(t, &slice[0], &result[0])
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("append_does_not_modify_input", func(t *testing.T) {
+		input := []int{1, 2, 3, 4, 5}
+		result := SliceFilterCopy(func(v int) bool { return v < 3 }, input)
+		_ = append(result, 99)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, input)
+	})
+}
+
+// indexedPositions returns the (sliceIdx, elemIdx) pairs for every element in order.
+func indexedPositions[T any](slices [][]T) [][2]int {
+	var result [][2]int
+	for sliceIdx, slice := range slices {
+		for elemIdx := range slice {
+			result = append(result, [2]int{sliceIdx, elemIdx})
+		}
+	}
+	return result
+}
+
+func TestSliceFilterIndexed(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceMultipleTestCases {
+		t.Run("multi-"+strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			var positions [][2]int
+			result := SliceFilterIndexed(func(sliceIdx, elemIdx int, v int) bool {
+				positions = append(positions, [2]int{sliceIdx, elemIdx})
+				assert.Equal(t, tt.slices[sliceIdx][elemIdx], v)
+				return tt.testFunc(v)
+			}, tt.slices...)
+			assert.Equal(t, indexedPositions(tt.slices), positions)
+			assert.Equal(t, SliceFilter(tt.testFunc, tt.slices...), result)
+		})
+	}
+
+	t.Run("every_other", func(t *testing.T) {
+		result := SliceFilterIndexed(func(_, elemIdx int, _ string) bool {
+			return elemIdx%2 == 0
+		}, []string{"a", "b", "c"}, []string{"d", "e"})
+		assert.Equal(t, []string{"a", "c", "d"}, result)
+	})
+
+	t.Run("skip_header_view", func(t *testing.T) {
+		input := []int{0, 1, 2, 3}
+		result := SliceFilterIndexed(func(_, elemIdx int, _ int) bool {
+			return elemIdx > 0
+		}, input)
+		require.Equal(t, []int{1, 2, 3}, result)
+		assert.Same(t, &input[1], &result[0]) // view retained
+	})
+
+	t.Run("zero_slices", func(t *testing.T) {
+		assert.Nil(t, SliceFilterIndexed(func(_, _ int, _ int) bool { return true }))
+	})
+}
+
 var sliceFilterTransformTestCases = []struct {
 	name           string
 	input          []int
@@ -1192,7 +1284,7 @@ func TestSliceFilterTransformErr(t *testing.T) {
 			[]int{1, 2, 3},
 		)
 		require.Error(t, err)
-		assert.Equal(t, "transform error", err.Error())
+		assertElementError(t, err, 0, 0, "transform error")
 		assert.Empty(t, result)
 	})
 
@@ -1208,7 +1300,7 @@ func TestSliceFilterTransformErr(t *testing.T) {
 			[]int{1, 2, 3, 4},
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 3", err.Error())
+		assertElementError(t, err, 0, 2, "error on 3")
 		assert.Equal(t, []string{"10", "20"}, result) // partial results before error
 	})
 
@@ -1224,7 +1316,7 @@ func TestSliceFilterTransformErr(t *testing.T) {
 			[]int{1, 2, 4, 6}, // 2,4,6 pass filter but error on 4
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 4", err.Error())
+		assertElementError(t, err, 0, 2, "error on 4")
 		assert.Equal(t, []string{"2"}, result) // partial results before error
 	})
 
@@ -1240,7 +1332,7 @@ func TestSliceFilterTransformErr(t *testing.T) {
 			[]int{1, 2}, []int{3, 4, 5}, // error occurs in second slice
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 4", err.Error())
+		assertElementError(t, err, 1, 1, "error on 4")
 		assert.Equal(t, []string{"1", "2", "3"}, result) // results from first slice + partial from second
 	})
 
@@ -1256,7 +1348,7 @@ func TestSliceFilterTransformErr(t *testing.T) {
 			[]int{1, 2, 3, 4, 5, 6, 7}, // 1,3,5,7 pass filter but error on 5
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 5", err.Error())
+		assertElementError(t, err, 0, 4, "error on 5")
 		assert.Equal(t, []string{"100", "300"}, result) // partial results before error
 	})
 
@@ -1273,7 +1365,7 @@ func TestSliceFilterTransformErr(t *testing.T) {
 			[]int{1, 2, 3, 4, 5}, // 1,2,3 consecutive, 4 skipped, 5 true -> triggers non-consecutive path
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 2", err.Error())
+		assertElementError(t, err, 0, 1, "error on 2")
 		assert.Equal(t, []string{"1"}, result) // partial result before error in consecutive section
 	})
 
@@ -1290,7 +1382,7 @@ func TestSliceFilterTransformErr(t *testing.T) {
 			[]int{1, 2, 3, 4, 5}, // 1,2 true, 3 false, 4,5 true -> triggers true+false+true path
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 4", err.Error())
+		assertElementError(t, err, 0, 3, "error on 4")
 		assert.Equal(t, []string{"10", "20"}, result) // results from initial true section only
 	})
 
@@ -1308,7 +1400,7 @@ func TestSliceFilterTransformErr(t *testing.T) {
 			[]int{1, 2, 3, 4, 5}, // 1 false, 2,3 true consecutive, 4 false, 5 true -> non-consecutive
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 3", err.Error())
+		assertElementError(t, err, 0, 2, "error on 3")
 		assert.Equal(t, []string{"2"}, result) // partial result from consecutive section before error
 	})
 
@@ -1326,9 +1418,214 @@ func TestSliceFilterTransformErr(t *testing.T) {
 			[]int{1, 2, 3, 4, 5}, // 1 false, 2,3 true consecutive, 4 false, 5 true -> non-consecutive
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 5", err.Error())
+		assertElementError(t, err, 0, 4, "error on 5")
 		assert.Equal(t, []string{"2", "3"}, result) // consecutive section processed, error on slice[j]
 	})
+
+	t.Run("error position in true suffix", func(t *testing.T) {
+		// The consecutive true suffix is transformed from an offset, the reported index must be absolute
+		result, err := SliceFilterTransformErr(
+			func(i int) bool { return i > 0 },
+			func(i int) (string, error) {
+				if i == 4 {
+					return "", errors.New("error on 4")
+				}
+				return strconv.Itoa(i), nil
+			},
+			[]int{5}, []int{-1, -2, 3, 4, 5},
+		)
+		assertElementError(t, err, 1, 3, "error on 4")
+		assert.Equal(t, []string{"5", "3"}, result)
+	})
+}
+
+func TestSliceFilterTransformErrRecover(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceFilterTransformTestCases {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			result, err := SliceFilterTransformErrRecover(tt.predicate, func(v int) (string, error) {
+				return tt.transform(v), nil
+			}, tt.input)
+			require.NoError(t, err)
+			if len(tt.expectedResult) == 0 {
+				assert.Empty(t, result)
+			} else {
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+
+	t.Run("predicate_panic", func(t *testing.T) {
+		result, err := SliceFilterTransformErrRecover(func(v int) bool {
+			if v == 4 {
+				panic("bad predicate")
+			}
+			return v%2 == 1
+		}, func(v int) (int, error) {
+			return v * 10, nil
+		}, []int{1, 2}, []int{3, 4, 5})
+		assert.Equal(t, []int{10, 30}, result)
+
+		var panicErr *PanicError
+		require.ErrorAs(t, err, &panicErr)
+		assert.Equal(t, "bad predicate", panicErr.Value)
+		assert.Contains(t, string(panicErr.Stack), "TestSliceFilterTransformErrRecover")
+		assertElementError(t, err, 1, 1, "panic: bad predicate")
+	})
+
+	t.Run("transform_panic_error_value", func(t *testing.T) {
+		errBad := errors.New("bad record")
+		_, err := SliceFilterTransformErrRecover(func(v int) bool { return true }, func(v int) (int, error) {
+			if v == 2 {
+				panic(errBad)
+			}
+			return v, nil
+		}, []int{1, 2, 3})
+		assert.ErrorIs(t, err, errBad)
+		assertElementError(t, err, 0, 1, "panic: bad record")
+	})
+
+	t.Run("transform_error", func(t *testing.T) {
+		result, err := SliceFilterTransformErrRecover(func(v int) bool { return true }, func(v int) (int, error) {
+			if v == 3 {
+				return 0, errors.New("error on 3")
+			}
+			return v, nil
+		}, []int{1, 2, 3})
+		assert.Equal(t, []int{1, 2}, result)
+		assertElementError(t, err, 0, 2, "error on 3")
+		var panicErr *PanicError
+		assert.False(t, errors.As(err, &panicErr))
+	})
+}
+
+func TestSliceFilterTransformErrRecoverInto(t *testing.T) {
+	t.Parallel()
+
+	result, err := SliceFilterTransformErrRecoverInto([]string{"start"}, func(v int) bool {
+		return v > 0
+	}, func(v int) (string, error) {
+		if v == 3 {
+			var m map[string]int
+			m["nil map"] = v // runtime panic
+		}
+		return strconv.Itoa(v), nil
+	}, []int{1, -2, 3})
+	assert.Equal(t, []string{"start", "1"}, result)
+
+	var panicErr *PanicError
+	require.ErrorAs(t, err, &panicErr)
+	var elemErr *ElementError
+	require.ErrorAs(t, err, &elemErr)
+	assert.Equal(t, 2, elemErr.ElementIndex)
+}
+
+func TestSliceFilterTransformTry(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceFilterTransformTestCases {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			result, err := SliceFilterTransformTry(tt.predicate, func(v int) (string, error) {
+				return tt.transform(v), nil
+			}, tt.input)
+			assert.NoError(t, err)
+			if len(tt.expectedResult) == 0 {
+				assert.Empty(t, result)
+			} else {
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+
+	t.Run("collects_all_errors", func(t *testing.T) {
+		errNegative := errors.New("negative")
+		result, err := SliceFilterTransformTry(func(v int) bool { return v != 0 }, func(v int) (int, error) {
+			if v < 0 {
+				return 0, errNegative
+			}
+			return v * 10, nil
+		}, []int{1, -2, 0, 3}, nil, []int{-4, 5})
+		assert.Equal(t, []int{10, 30, 50}, result)
+
+		var errs ElementErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		assert.Equal(t, ElementError{SliceIndex: 0, ElementIndex: 1, Err: errNegative}, *errs[0])
+		assert.Equal(t, ElementError{SliceIndex: 2, ElementIndex: 0, Err: errNegative}, *errs[1])
+		assert.ErrorIs(t, err, errNegative)
+	})
+
+	t.Run("all_fail", func(t *testing.T) {
+		result, err := SliceFilterTransformTry(func(v int) bool { return true }, func(v int) (int, error) {
+			return 0, errors.New("fail")
+		}, []int{1, 2})
+		assert.Empty(t, result)
+		var errs ElementErrors
+		require.ErrorAs(t, err, &errs)
+		assert.Len(t, errs, 2)
+	})
+
+	t.Run("zero_slices", func(t *testing.T) {
+		result, err := SliceFilterTransformTry(func(v int) bool { return true }, func(v int) (int, error) {
+			return v, nil
+		})
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestSliceFilterTransformInPlace(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceTestCases {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			input := sliceDup(tt.input)
+			result := SliceFilterTransformInPlace(tt.testFunc, func(v int) int { return v * 10 }, input)
+			expected := SliceTransform(func(v int) int { return v * 10 }, tt.expectTrue)
+			if len(expected) == 0 {
+				assert.Empty(t, result)
+			} else {
+				assert.Equal(t, expected, result)
+				assert.Same(t, &input[0], &result[0])
+			}
+		})
+	}
+
+	t.Run("normalize_strings", func(t *testing.T) {
+		input := []string{" A ", "", "b", "  "}
+		result := SliceFilterTransformInPlace(func(s string) bool {
+			return strings.TrimSpace(s) != ""
+		}, func(s string) string {
+			return strings.ToLower(strings.TrimSpace(s))
+		}, input)
+		assert.Equal(t, []string{"a", "b"}, result)
+	})
+}
+
+func TestSliceFilterTransformErrInPlace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no_error", func(t *testing.T) {
+		result, err := SliceFilterTransformErrInPlace(func(v int) bool { return v > 0 }, func(v int) (int, error) {
+			return v + 1, nil
+		}, []int{1, -1, 2})
+		require.NoError(t, err)
+		assert.Equal(t, []int{2, 3}, result)
+	})
+
+	t.Run("error_leaves_suffix", func(t *testing.T) {
+		input := []int{1, -1, 2, 3, -2, 4}
+		result, err := SliceFilterTransformErrInPlace(func(v int) bool { return v > 0 }, func(v int) (int, error) {
+			if v == 3 {
+				return 0, errors.New("error on 3")
+			}
+			return v * 10, nil
+		}, input)
+		assertElementError(t, err, 0, 3, "error on 3")
+		assert.Equal(t, []int{10, 20}, result)
+		assert.Equal(t, []int{3, -2, 4}, input[3:]) // suffix from the failing element is intact
+	})
 }
 
 func TestSliceFilterTransformInto(t *testing.T) {
@@ -1454,7 +1751,7 @@ func TestSliceFilterTransformErrInto(t *testing.T) {
 			[]int{1, 2, 3},
 		)
 		require.Error(t, err)
-		assert.Equal(t, "transform error", err.Error())
+		assertElementError(t, err, 0, 0, "transform error")
 		assert.Equal(t, []string{"existing"}, result) // no new elements added
 	})
 
@@ -1472,7 +1769,7 @@ func TestSliceFilterTransformErrInto(t *testing.T) {
 			[]int{1, 2, 3, 4},
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 3", err.Error())
+		assertElementError(t, err, 0, 2, "error on 3")
 		assert.Equal(t, []string{"start", "10", "20"}, result) // partial results appended
 	})
 
@@ -1490,7 +1787,7 @@ func TestSliceFilterTransformErrInto(t *testing.T) {
 			[]int{1, 2, 3}, []int{4, 5, 6, 7}, // error in second slice
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 5", err.Error())
+		assertElementError(t, err, 1, 1, "error on 5")
 		assert.Equal(t, []string{"100", "300"}, result) // results from first slice only
 	})
 }
@@ -1751,6 +2048,236 @@ func TestSliceSplit(t *testing.T) {
 	})
 }
 
+func TestSliceSplitCopy(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceTestCases {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			input := sliceDup(tt.input)
+			original := sliceDup(tt.input)
+			trueSlice, falseSlice := SliceSplitCopy(tt.testFunc, input)
+			if len(tt.expectTrue) == 0 {
+				assert.Nil(t, trueSlice)
+			} else {
+				assert.Equal(t, tt.expectTrue, trueSlice)
+				assert.Equal(t, len(trueSlice), cap(trueSlice))
+				trueSlice[0] = -100
+			}
+			if len(tt.expectFalse) == 0 {
+				assert.Nil(t, falseSlice)
+			} else {
+				assert.Equal(t, tt.expectFalse, falseSlice)
+				assert.Equal(t, len(falseSlice), cap(falseSlice))
+				falseSlice[0] = -100
+			}
+			assert.Equal(t, original, input)
+		})
+	}
+
+	for i, tt := range sliceMultipleTestCases {
+		t.Run("multi-"+strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			trueSlice, falseSlice := SliceSplitCopy(tt.testFunc, tt.slices...)
+			if len(tt.expectTrue) == 0 {
+				assert.Nil(t, trueSlice)
+			} else {
+				assert.Equal(t, tt.expectTrue, trueSlice)
+				assert.Equal(t, len(trueSlice), cap(trueSlice))
+			}
+			if len(tt.expectFalse) == 0 {
+				assert.Nil(t, falseSlice)
+			} else {
+				assert.Equal(t, tt.expectFalse, falseSlice)
+				assert.Equal(t, len(falseSlice), cap(falseSlice))
+			}
+		})
+	}
+
+	t.Run("predicate_order", func(t *testing.T) {
+		slices := [][]int{{1, 2, 3}, nil, {4, 4, 5}, {6}}
+		var visited []int
+		trueSlice, falseSlice := SliceSplitCopy(func(v int) bool {
+			visited = append(visited, v)
+			return v%2 == 0
+		}, slices...)
+
+		assert.Equal(t, []int{1, 2, 3, 4, 4, 5, 6}, visited) // once per element in index order
+		assert.Equal(t, []int{2, 4, 4, 6}, trueSlice)
+		assert.Equal(t, []int{1, 3, 5}, falseSlice)
+	})
+
+	t.Run("append_does_not_modify_other", func(t *testing.T) {
+		trueSlice, falseSlice := SliceSplitCopy(func(v int) bool { return v < 3 }, []int{1, 3, 2, 4})
+		_ = append(trueSlice, 99)
+		assert.Equal(t, []int{3, 4}, falseSlice)
+	})
+}
+
+func TestSliceSplitIndexed(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceMultipleTestCases {
+		t.Run("multi-"+strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			var positions [][2]int
+			trueSlice, falseSlice := SliceSplitIndexed(func(sliceIdx, elemIdx int, v int) bool {
+				positions = append(positions, [2]int{sliceIdx, elemIdx})
+				assert.Equal(t, tt.slices[sliceIdx][elemIdx], v)
+				return tt.testFunc(v)
+			}, tt.slices...)
+			assert.Equal(t, indexedPositions(tt.slices), positions)
+			expectTrue, expectFalse := SliceSplit(tt.testFunc, tt.slices...)
+			assert.Equal(t, expectTrue, trueSlice)
+			assert.Equal(t, expectFalse, falseSlice)
+		})
+	}
+
+	t.Run("by_slice", func(t *testing.T) {
+		trueSlice, falseSlice := SliceSplitIndexed(func(sliceIdx, _ int, _ int) bool {
+			return sliceIdx == 1
+		}, []int{1, 2}, []int{3, 4}, []int{5})
+		assert.Equal(t, []int{3, 4}, trueSlice)
+		assert.Equal(t, []int{1, 2, 5}, falseSlice)
+	})
+}
+
+func TestSliceSplit3(t *testing.T) {
+	t.Parallel()
+
+	compareTo := func(pivot int) func(int) int {
+		return func(v int) int { return v - pivot }
+	}
+
+	t.Run("mixed", func(t *testing.T) {
+		less, equal, greater := SliceSplit3(compareTo(3), []int{5, 3, 1, 3}, []int{4, 2})
+		assert.Equal(t, []int{1, 2}, less)
+		assert.Equal(t, []int{3, 3}, equal)
+		assert.Equal(t, []int{5, 4}, greater)
+	})
+
+	t.Run("all_equal_view", func(t *testing.T) {
+		input := []int{3, 3, 3}
+		less, equal, greater := SliceSplit3(compareTo(3), input)
+		assert.Nil(t, less)
+		assert.Nil(t, greater)
+		require.Equal(t, input, equal)
+		assert.Same(t, &input[0], &equal[0])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		less, equal, greater := SliceSplit3(compareTo(3))
+		assert.Nil(t, less)
+		assert.Nil(t, equal)
+		assert.Nil(t, greater)
+	})
+}
+
+func TestSlicePartitionN(t *testing.T) {
+	t.Parallel()
+
+	mod3 := func(v int) int { return v % 3 }
+
+	for i, tt := range sliceMultipleTestCases {
+		t.Run("multi-"+strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			buckets := SlicePartitionN(func(v int) int {
+				if tt.testFunc(v) {
+					return 1
+				}
+				return 0
+			}, 2, tt.slices...)
+			require.Len(t, buckets, 2)
+			expectTrue, expectFalse := SliceSplit(tt.testFunc, tt.slices...)
+			if len(expectTrue) == 0 {
+				assert.Empty(t, buckets[1])
+			} else {
+				assert.Equal(t, expectTrue, buckets[1])
+			}
+			if len(expectFalse) == 0 {
+				assert.Empty(t, buckets[0])
+			} else {
+				assert.Equal(t, expectFalse, buckets[0])
+			}
+		})
+	}
+
+	t.Run("order_preserved", func(t *testing.T) {
+		buckets := SlicePartitionN(mod3, 4, []int{1, 2, 3, 4, 5}, []int{6, 7, 9})
+		assert.Equal(t, [][]int{{3, 6, 9}, {1, 4, 7}, {2, 5}, nil}, buckets)
+	})
+
+	t.Run("classify_once", func(t *testing.T) {
+		var calls int
+		SlicePartitionN(func(v int) int {
+			calls++
+			return v % 2
+		}, 2, []int{1, 2, 3, 4})
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("bucket_capacity_isolated", func(t *testing.T) {
+		buckets := SlicePartitionN(mod3, 3, []int{0, 1, 2, 3, 4, 5})
+		assert.Equal(t, 2, cap(buckets[0]))
+		_ = append(buckets[0], 99)
+		assert.Equal(t, []int{1, 4}, buckets[1])
+	})
+
+	t.Run("single_bucket_view", func(t *testing.T) {
+		input := []int{3, 6}
+		buckets := SlicePartitionN(mod3, 3, input)
+		assert.Same(t, &input[0], &buckets[0][0])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, [][]int{nil, nil}, SlicePartitionN(mod3, 2))
+	})
+
+	t.Run("out_of_range", func(t *testing.T) {
+		assert.Panics(t, func() { SlicePartitionN(mod3, 2, []int{2}) })
+		assert.Panics(t, func() { SlicePartitionN(func(v int) int { return -1 }, 2, []int{2}) })
+	})
+}
+
+func TestSlicePartitionNInPlaceUnstable(t *testing.T) {
+	t.Parallel()
+
+	mod := func(n int) func(int) int {
+		return func(v int) int { return v % n }
+	}
+
+	t.Run("buckets", func(t *testing.T) {
+		input := make([]int, 200)
+		for i := range input {
+			input[i] = (i * 37) % 101
+		}
+		expected := SlicePartitionN(mod(7), 7, input)
+		counts := SliceToCounts(input)
+		inputPtr := &input[0]
+
+		buckets := SlicePartitionNInPlaceUnstable(mod(7), 7, input)
+		require.Len(t, buckets, 7)
+		assert.Same(t, inputPtr, &buckets[0][0]) // views of the input
+		for bucketIdx, bucket := range buckets {
+			assert.ElementsMatch(t, expected[bucketIdx], bucket)
+			assert.Equal(t, len(bucket), cap(bucket))
+		}
+		assert.Equal(t, counts, SliceToCounts(buckets...))
+	})
+
+	t.Run("empty_buckets", func(t *testing.T) {
+		buckets := SlicePartitionNInPlaceUnstable(mod(4), 4, []int{3, 1, 3, 1})
+		assert.Nil(t, buckets[0])
+		assert.Nil(t, buckets[2])
+		assert.Equal(t, []int{1, 1}, buckets[1])
+		assert.Equal(t, []int{3, 3}, buckets[3])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, [][]int{nil, nil}, SlicePartitionNInPlaceUnstable(mod(2), 2, nil))
+	})
+
+	t.Run("out_of_range", func(t *testing.T) {
+		assert.Panics(t, func() { SlicePartitionNInPlaceUnstable(mod(3), 2, []int{2}) })
+	})
+}
+
 func TestSliceSplitInPlace(t *testing.T) {
 	t.Parallel()
 
@@ -2087,6 +2614,129 @@ func TestSliceTransform(t *testing.T) {
 	})
 }
 
+func TestSliceTransformErrRecover(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no_panic", func(t *testing.T) {
+		result, err := SliceTransformErrRecover(strconv.Atoi, []string{"1", "2"}, []string{"3"})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, result)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		result, err := SliceTransformErrRecover(func(s string) (int, error) {
+			return int(s[0]), nil // index out of range on empty strings
+		}, []string{"a"}, []string{"b", ""})
+		assert.Equal(t, []int{'a', 'b'}, result)
+
+		var panicErr *PanicError
+		require.ErrorAs(t, err, &panicErr)
+		var elemErr *ElementError
+		require.ErrorAs(t, err, &elemErr)
+		assert.Equal(t, 1, elemErr.SliceIndex)
+		assert.Equal(t, 1, elemErr.ElementIndex)
+	})
+}
+
+func TestSliceTransformInPlace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, SliceTransformInPlace(strings.ToUpper, nil))
+	})
+
+	t.Run("values", func(t *testing.T) {
+		input := []string{"a", "B", "c"}
+		result := SliceTransformInPlace(strings.ToUpper, input)
+		assert.Equal(t, []string{"A", "B", "C"}, result)
+		assert.Same(t, &input[0], &result[0])
+	})
+}
+
+func TestSliceTransformErrInPlace(t *testing.T) {
+	t.Parallel()
+
+	double := func(v int) (int, error) {
+		if v < 0 {
+			return 0, fmt.Errorf("negative %d", v)
+		}
+		return v * 2, nil
+	}
+
+	t.Run("no_error", func(t *testing.T) {
+		input := []int{1, 2, 3}
+		result, err := SliceTransformErrInPlace(double, input)
+		require.NoError(t, err)
+		assert.Equal(t, []int{2, 4, 6}, result)
+		assert.Equal(t, []int{2, 4, 6}, input)
+	})
+
+	t.Run("error_leaves_suffix", func(t *testing.T) {
+		input := []int{1, 2, -3, 4}
+		result, err := SliceTransformErrInPlace(double, input)
+		assertElementError(t, err, 0, 2, "negative -3")
+		assert.Equal(t, []int{2, 4}, result)
+		assert.Equal(t, []int{2, 4, -3, 4}, input)
+	})
+}
+
+func TestSliceTransformTry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no_errors", func(t *testing.T) {
+		result, err := SliceTransformTry(strconv.Atoi, []string{"1", "2"}, []string{"3"})
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, result)
+	})
+
+	t.Run("skips_failures", func(t *testing.T) {
+		result, err := SliceTransformTry(strconv.Atoi, []string{"1", "x", "2"}, []string{"y", "3"})
+		assert.Equal(t, []int{1, 2, 3}, result)
+
+		var errs ElementErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 2)
+		assert.Equal(t, 0, errs[0].SliceIndex)
+		assert.Equal(t, 1, errs[0].ElementIndex)
+		assert.Equal(t, 1, errs[1].SliceIndex)
+		assert.Equal(t, 0, errs[1].ElementIndex)
+
+		var numErr *strconv.NumError
+		require.ErrorAs(t, err, &numErr)
+		assert.Equal(t, "x", numErr.Num)
+		assert.ErrorIs(t, err, strconv.ErrSyntax)
+	})
+}
+
+func TestSliceTransformIndexed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero_slices", func(t *testing.T) {
+		result := SliceTransformIndexed(func(_, _ int, v int) int { return v })
+		assert.Empty(t, result)
+	})
+
+	t.Run("positions", func(t *testing.T) {
+		slices := [][]int{{10, 20}, nil, {}, {30}, {40, 50, 60}}
+		result := SliceTransformIndexed(func(sliceIdx, elemIdx int, v int) string {
+			assert.Equal(t, slices[sliceIdx][elemIdx], v)
+			return fmt.Sprintf("%d:%d=%d", sliceIdx, elemIdx, v)
+		}, slices...)
+		assert.Equal(t, []string{"0:0=10", "0:1=20", "3:0=30", "4:0=40", "4:1=50", "4:2=60"}, result)
+	})
+
+	t.Run("previous_element", func(t *testing.T) {
+		input := []int{1, 4, 9, 16}
+		result := SliceTransformIndexed(func(_, elemIdx int, v int) int {
+			if elemIdx == 0 {
+				return 0
+			}
+			return v - input[elemIdx-1]
+		}, input)
+		assert.Equal(t, []int{0, 3, 5, 7}, result)
+	})
+}
+
 func TestSliceTransformErr(t *testing.T) {
 	t.Parallel()
 
@@ -2163,7 +2813,7 @@ func TestSliceTransformErr(t *testing.T) {
 			[]int{1, 2, 3},
 		)
 		require.Error(t, err)
-		assert.Equal(t, "transform error", err.Error())
+		assertElementError(t, err, 0, 0, "transform error")
 		assert.Empty(t, result)
 	})
 
@@ -2178,7 +2828,7 @@ func TestSliceTransformErr(t *testing.T) {
 			[]int{1, 2, 3, 4},
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 3", err.Error())
+		assertElementError(t, err, 0, 2, "error on 3")
 		assert.Equal(t, []string{"10", "20"}, result) // partial results before error
 	})
 
@@ -2193,7 +2843,7 @@ func TestSliceTransformErr(t *testing.T) {
 			[]int{1, 2}, []int{3, 4, 5}, // error occurs in second slice
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 4", err.Error())
+		assertElementError(t, err, 1, 1, "error on 4")
 		assert.Equal(t, []string{"1", "2", "3"}, result) // results from first slice + partial from second
 	})
 
@@ -2208,7 +2858,7 @@ func TestSliceTransformErr(t *testing.T) {
 			[]int{1, 2}, []int{3, 4}, // error at end of first slice
 		)
 		require.Error(t, err)
-		assert.Equal(t, "error on 2", err.Error())
+		assertElementError(t, err, 0, 1, "error on 2")
 		assert.Equal(t, []string{"100"}, result) // partial result from first slice only
 	})
 }
@@ -2276,6 +2926,178 @@ var sliceToSetTests = []struct {
 	},
 }
 
+var sliceQueryTests = []struct {
+	name      string
+	slices    [][]int
+	predicate func(int) bool
+	any       bool
+	all       bool
+	first     int
+	firstIdx  int
+	last      int
+	count     int
+}{
+	{
+		name:      "no_slices",
+		slices:    nil,
+		predicate: func(v int) bool { return v > 0 },
+		all:       true,
+		firstIdx:  -1,
+	},
+	{
+		name:      "empty_slices",
+		slices:    [][]int{{}, nil},
+		predicate: func(v int) bool { return v > 0 },
+		all:       true,
+		firstIdx:  -1,
+	},
+	{
+		name:      "none_match",
+		slices:    [][]int{{-1, -2}, {-3}},
+		predicate: func(v int) bool { return v > 0 },
+		firstIdx:  -1,
+	},
+	{
+		name:      "all_match",
+		slices:    [][]int{{1, 2}, {3}},
+		predicate: func(v int) bool { return v > 0 },
+		any:       true,
+		all:       true,
+		first:     1,
+		firstIdx:  0,
+		last:      3,
+		count:     3,
+	},
+	{
+		name:      "match_in_later_slice",
+		slices:    [][]int{{-1, -2}, nil, {-3, 4, -5, 6}, {-7}},
+		predicate: func(v int) bool { return v > 0 },
+		any:       true,
+		first:     4,
+		firstIdx:  3,
+		last:      6,
+		count:     2,
+	},
+	{
+		name:      "single_match",
+		slices:    [][]int{{0, 0, 9}},
+		predicate: func(v int) bool { return v > 0 },
+		any:       true,
+		first:     9,
+		firstIdx:  2,
+		last:      9,
+		count:     1,
+	},
+}
+
+func TestSliceQueries(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceQueryTests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.any, SliceAny(tt.predicate, tt.slices...))
+			assert.Equal(t, tt.all, SliceAll(tt.predicate, tt.slices...))
+			assert.Equal(t, !tt.any, SliceNone(tt.predicate, tt.slices...))
+			assert.Equal(t, tt.firstIdx, SliceFindIndex(tt.predicate, tt.slices...))
+			assert.Equal(t, tt.count, SliceCount(tt.predicate, tt.slices...))
+
+			first, ok := SliceFind(tt.predicate, tt.slices...)
+			assert.Equal(t, tt.any, ok)
+			assert.Equal(t, tt.first, first)
+			last, ok := SliceFindLast(tt.predicate, tt.slices...)
+			assert.Equal(t, tt.any, ok)
+			assert.Equal(t, tt.last, last)
+		})
+	}
+
+	t.Run("short_circuit", func(t *testing.T) {
+		var calls int
+		countingPositive := func(v int) bool {
+			calls++
+			return v > 0
+		}
+		input := [][]int{{-1, 2, 3}, {4, -5}}
+
+		calls = 0
+		assert.True(t, SliceAny(countingPositive, input...))
+		assert.Equal(t, 2, calls)
+		calls = 0
+		assert.False(t, SliceAll(countingPositive, input...))
+		assert.Equal(t, 1, calls)
+		calls = 0
+		assert.False(t, SliceNone(countingPositive, input...))
+		assert.Equal(t, 2, calls)
+		calls = 0
+		_, _ = SliceFind(countingPositive, input...)
+		assert.Equal(t, 2, calls)
+		calls = 0
+		_, _ = SliceFindLast(countingPositive, input...)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("find_index_selects", func(t *testing.T) {
+		input := [][]string{{"a", "b"}, {"c", "d"}}
+		idx := SliceFindIndex(func(v string) bool { return v == "c" }, input...)
+		assert.Equal(t, []string{"c"}, SliceSelectIndexes([]int{idx}, input...))
+	})
+}
+
+func TestSliceIndexesWhere(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceTestCases {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			indexes := SliceIndexesWhere(tt.testFunc, tt.input)
+			if len(tt.expectTrue) == 0 {
+				assert.Nil(t, indexes)
+			} else {
+				assert.Equal(t, tt.expectTrue, SliceSelectIndexes(indexes, tt.input))
+			}
+		})
+	}
+
+	t.Run("positions", func(t *testing.T) {
+		indexes := SliceIndexesWhere(func(v string) bool { return v != "" }, []string{"", "a", "", "b", "c"})
+		assert.Equal(t, []int{1, 3, 4}, indexes)
+	})
+}
+
+func TestSliceSelectIndexes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty_indexes", func(t *testing.T) {
+		assert.Nil(t, SliceSelectIndexes(nil, []int{1, 2}))
+	})
+
+	t.Run("parallel_columns", func(t *testing.T) {
+		ids := []int64{10, 20, 30, 40}
+		scores := []float64{0.5, 0.9, 0.1, 0.7}
+		names := []string{"a", "b", "c", "d"}
+		indexes := SliceIndexesWhere(func(v float64) bool { return v > 0.6 }, scores)
+		assert.Equal(t, []int64{20, 40}, SliceSelectIndexes(indexes, ids))
+		assert.Equal(t, []float64{0.9, 0.7}, SliceSelectIndexes(indexes, scores))
+		names = SliceSelectIndexes(indexes, names)
+		assert.Equal(t, []string{"b", "d"}, names)
+		assert.Equal(t, 2, cap(names))
+	})
+
+	t.Run("index_order_and_duplicates", func(t *testing.T) {
+		assert.Equal(t, []int{3, 1, 3}, SliceSelectIndexes([]int{2, 0, 2}, []int{1, 2, 3}))
+	})
+
+	t.Run("multiple_slices", func(t *testing.T) {
+		slices := [][]int{{1, 2}, nil, {}, {3}, {4, 5, 6}}
+		result := SliceSelectIndexes([]int{0, 1, 2, 3, 5}, slices...)
+		assert.Equal(t, []int{1, 2, 3, 4, 6}, result)
+	})
+
+	t.Run("out_of_range", func(t *testing.T) {
+		assert.Panics(t, func() { SliceSelectIndexes([]int{3}, []int{1, 2, 3}) })
+		assert.Panics(t, func() { SliceSelectIndexes([]int{3}, []int{1}, []int{2, 3}) })
+		assert.Panics(t, func() { SliceSelectIndexes([]int{-1}, []int{1}, []int{2, 3}) })
+	})
+}
+
 func TestSliceToSet(t *testing.T) {
 	t.Parallel()
 
@@ -3165,6 +3987,85 @@ func TestSliceDifference(t *testing.T) {
 	})
 }
 
+func TestSliceIntersectCopy(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceSetOperationTests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			result := SliceIntersectCopy(tt.sliceA, tt.sliceB)
+			if len(tt.expectedIntersect) == 0 {
+				assert.Nil(t, result)
+			} else {
+				assert.Equal(t, tt.expectedIntersect, result)
+				assert.Equal(t, len(result), cap(result))
+			}
+		})
+	}
+}
+
+func TestSliceDifferenceCopy(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceSetOperationTests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			result := SliceDifferenceCopy(tt.sliceA, tt.sliceB)
+			if len(tt.expectedDifference) == 0 {
+				assert.Nil(t, result)
+			} else {
+				assert.Equal(t, tt.expectedDifference, result)
+				assert.Equal(t, len(result), cap(result))
+			}
+		})
+	}
+
+	t.Run("empty_b_not_view", func(t *testing.T) {
+		a := []int{1, 2, 3}
+		result := SliceDifferenceCopy(a, nil)
+		require.Len(t, result, 3)
+		result[0] = 100
+		assert.Equal(t, []int{1, 2, 3}, a)
+	})
+}
+
+// TestSliceCopyAllocations is not run in parallel so that allocation measurements only include the operation.
+func TestSliceCopyAllocations(t *testing.T) {
+	positive := func(v int) bool { return v > 0 }
+	even := func(v int) bool { return v%2 == 0 }
+
+	t.Run("filter_owned_exact", func(t *testing.T) {
+		input := []int{1, 2, 0, 3, 4} // filtered into an exact allocation which is returned without a copy
+		assert.Equal(t, 1.0, testing.AllocsPerRun(100, func() {
+			SliceFilterCopy(positive, input)
+		}))
+	})
+	t.Run("filter_multi_views", func(t *testing.T) {
+		a, b := []int{1, 2, 3}, []int{0, 4, 5}
+		assert.Equal(t, 2.0, testing.AllocsPerRun(100, func() {
+			SliceFilterCopy(positive, a, b)
+		}))
+	})
+	t.Run("split_owned_exact", func(t *testing.T) {
+		input := []int{1, 2} // both results are split into exact allocations which are returned without a copy
+		assert.Equal(t, 2.0, testing.AllocsPerRun(100, func() {
+			SliceSplitCopy(even, input)
+		}))
+	})
+	t.Run("split_view", func(t *testing.T) {
+		input := []int{2, 4, 6} // only the view half is copied
+		assert.Equal(t, 1.0, testing.AllocsPerRun(100, func() {
+			SliceSplitCopy(even, input)
+		}))
+	})
+	t.Run("intersect_owned_exact", func(t *testing.T) {
+		a, b := []int{1, 2, 3}, []int{3, 2, 1}
+		assert.Equal(t, testing.AllocsPerRun(100, func() {
+			SliceIntersect(a, b)
+		}), testing.AllocsPerRun(100, func() {
+			SliceIntersectCopy(a, b)
+		}))
+	})
+}
+
 func TestSliceTotalSize(t *testing.T) {
 	t.Parallel()
 
@@ -3672,3 +4573,175 @@ func TestSlicePrepend(t *testing.T) {
 		assert.Equal(t, expected, result)
 	})
 }
+
+var sliceRemoveIndexesTests = []struct {
+	name     string
+	input    []int
+	idxs     []int
+	expected []int
+	isView   bool
+}{
+	{name: "nil", input: nil, idxs: nil, expected: nil, isView: true},
+	{name: "no_indexes", input: []int{1, 2, 3}, idxs: nil, expected: []int{1, 2, 3}, isView: true},
+	{name: "prefix", input: []int{1, 2, 3, 4}, idxs: []int{0, 1}, expected: []int{3, 4}, isView: true},
+	{name: "suffix", input: []int{1, 2, 3, 4}, idxs: []int{2, 3}, expected: []int{1, 2}, isView: true},
+	{name: "all", input: []int{1, 2, 3}, idxs: []int{0, 1, 2}, expected: []int{}, isView: true},
+	{name: "middle", input: []int{1, 2, 3, 4}, idxs: []int{1, 2}, expected: []int{1, 4}},
+	{name: "scattered", input: []int{0, 1, 2, 3, 4, 5, 6}, idxs: []int{1, 3, 5}, expected: []int{0, 2, 4, 6}},
+	{name: "first_and_last", input: []int{0, 1, 2, 3}, idxs: []int{0, 3}, expected: []int{1, 2}},
+}
+
+func TestSliceRemoveIndexes(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceRemoveIndexesTests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			input := sliceDup(tt.input)
+			idxs := sliceDup(tt.idxs)
+			result := SliceRemoveIndexes(input, idxs)
+			if len(tt.expected) == 0 {
+				assert.Empty(t, result)
+			} else {
+				assert.Equal(t, tt.expected, result)
+				if !tt.isView {
+					assert.Equal(t, len(result), cap(result))
+				}
+			}
+			assert.Equal(t, sliceDup(tt.input), input) // input unchanged
+			assert.Equal(t, sliceDup(tt.idxs), idxs)   // indexes unchanged
+		})
+	}
+
+	t.Run("out_of_range", func(t *testing.T) {
+		assert.Panics(t, func() { SliceRemoveIndexes([]int{1}, []int{1}) })
+		assert.Panics(t, func() { SliceRemoveIndexes([]int{1}, []int{-1}) })
+	})
+
+	t.Run("not_ascending", func(t *testing.T) {
+		assert.PanicsWithValue(t, "bulk: index 1 does not follow 3 in strictly ascending order", func() {
+			SliceRemoveIndexes([]int{0, 1, 2, 3, 4}, []int{3, 1})
+		})
+		assert.PanicsWithValue(t, "bulk: index 3 does not follow 3 in strictly ascending order", func() {
+			SliceRemoveIndexes([]int{0, 1, 2, 3, 4}, []int{1, 3, 3})
+		})
+	})
+}
+
+func TestSliceRemoveIndexesInPlace(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceRemoveIndexesTests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			input := sliceDup(tt.input)
+			result := SliceRemoveIndexesInPlace(input, tt.idxs)
+			if len(tt.expected) == 0 {
+				assert.Empty(t, result)
+			} else {
+				assert.Equal(t, tt.expected, result)
+				assert.Same(t, &input[0], &result[0])
+			}
+		})
+	}
+
+	t.Run("out_of_range", func(t *testing.T) {
+		assert.Panics(t, func() { SliceRemoveIndexesInPlace([]int{1}, []int{2}) })
+	})
+
+	t.Run("not_ascending", func(t *testing.T) {
+		assert.Panics(t, func() { SliceRemoveIndexesInPlace([]int{0, 1, 2}, []int{1, 0}) })
+	})
+}
+
+// TestSliceRemoveIndexesAllocations is not run in parallel so that allocation measurements only include the removal.
+func TestSliceRemoveIndexesAllocations(t *testing.T) {
+	input := []int{0, 1, 2, 3, 4, 5, 6}
+	idxs := []int{1, 3, 5}
+
+	assert.Equal(t, 1.0, testing.AllocsPerRun(100, func() {
+		SliceRemoveIndexes(input, idxs)
+	}))
+	buf := make([]int, len(input))
+	assert.Equal(t, 0.0, testing.AllocsPerRun(100, func() {
+		copy(buf, input)
+		SliceRemoveIndexesInPlace(buf, idxs)
+	}))
+}
+
+func TestSliceInsertAt(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []int
+		idx      int
+		values   []int
+		expected []int
+	}{
+		{name: "nil_input", input: nil, idx: 0, values: []int{1}, expected: []int{1}},
+		{name: "no_values", input: []int{1, 2}, idx: 1, values: nil, expected: []int{1, 2}},
+		{name: "front", input: []int{3, 4}, idx: 0, values: []int{1, 2}, expected: []int{1, 2, 3, 4}},
+		{name: "middle", input: []int{1, 4}, idx: 1, values: []int{2, 3}, expected: []int{1, 2, 3, 4}},
+		{name: "end", input: []int{1, 2}, idx: 2, values: []int{3}, expected: []int{1, 2, 3}},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			input := sliceDup(tt.input)
+			result := SliceInsertAt(input, tt.idx, tt.values...)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, len(tt.expected), cap(result))
+			assert.Equal(t, sliceDup(tt.input), input)
+		})
+	}
+
+	t.Run("out_of_range", func(t *testing.T) {
+		assert.Panics(t, func() { SliceInsertAt([]int{1}, 2, 0) })
+		assert.Panics(t, func() { SliceInsertAt([]int{1}, -1, 0) })
+	})
+}
+
+func TestSliceSwapRemove(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{1, 4, 3}, SliceSwapRemove([]int{1, 2, 3, 4}, 1))
+	assert.Equal(t, []int{1, 2, 3}, SliceSwapRemove([]int{1, 2, 3, 4}, 3))
+	assert.Empty(t, SliceSwapRemove([]int{1}, 0))
+	assert.Panics(t, func() { SliceSwapRemove([]int{1}, 1) })
+	assert.Panics(t, func() { SliceSwapRemove([]int{}, 0) })
+}
+
+func TestSliceMove(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		start, end int
+		to         int
+		expected   []int
+	}{
+		{name: "no_move", start: 2, end: 4, to: 2, expected: []int{0, 1, 2, 3, 4, 5}},
+		{name: "empty_range", start: 2, end: 2, to: 0, expected: []int{0, 1, 2, 3, 4, 5}},
+		{name: "to_front", start: 3, end: 5, to: 0, expected: []int{3, 4, 0, 1, 2, 5}},
+		{name: "to_back", start: 0, end: 2, to: 4, expected: []int{2, 3, 4, 5, 0, 1}},
+		{name: "single_back", start: 1, end: 2, to: 3, expected: []int{0, 2, 3, 1, 4, 5}},
+		{name: "single_forward", start: 4, end: 5, to: 1, expected: []int{0, 4, 1, 2, 3, 5}},
+		{name: "whole", start: 0, end: 6, to: 0, expected: []int{0, 1, 2, 3, 4, 5}},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			input := []int{0, 1, 2, 3, 4, 5}
+			SliceMove(input, tt.start, tt.end, tt.to)
+			assert.Equal(t, tt.expected, input)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		input := []int{0, 1, 2}
+		assert.Panics(t, func() { SliceMove(input, 2, 1, 0) })
+		assert.Panics(t, func() { SliceMove(input, 0, 4, 0) })
+		assert.Panics(t, func() { SliceMove(input, -1, 1, 0) })
+		assert.Panics(t, func() { SliceMove(input, 0, 2, 2) })
+		assert.Panics(t, func() { SliceMove(input, 0, 2, -1) })
+	})
+}
@@ -0,0 +1,505 @@
+package bulk
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// keyedSorter sorts values by a parallel slice of precomputed keys.
+type keyedSorter[T any, K ordered] struct {
+	values []T
+	keys   []K
+}
+
+func (s keyedSorter[T, K]) Len() int {
+	return len(s.values)
+}
+
+func (s keyedSorter[T, K]) Less(i, j int) bool {
+	return s.keys[i] < s.keys[j]
+}
+
+func (s keyedSorter[T, K]) Swap(i, j int) {
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+func newKeyedSorter[T any, K ordered](keyfunc func(T) K, values []T) keyedSorter[T, K] {
+	keys := make([]K, len(values))
+	for i, v := range values {
+		keys[i] = keyfunc(v)
+	}
+	return keyedSorter[T, K]{values: values, keys: keys}
+}
+
+// SliceSortBy returns a new slice containing the elements of all provided slices, sorted in ascending order of the
+// key returned by keyfunc. The keyfunc is invoked exactly once per element, with keys cached for comparisons.
+// The sort is not stable, use SliceSortStableBy if the original order of equal keys must be retained.
+func SliceSortBy[T any, K ordered](keyfunc func(T) K, slices ...[]T) []T {
+	result := sliceConcatCopy(slices)
+	sort.Sort(newKeyedSorter(keyfunc, result))
+	return result
+}
+
+// SliceSortByInPlace sorts the elements of all provided slices in ascending order of the key returned by keyfunc.
+// The input is sorted directly when only one slice is non-empty, otherwise the elements are combined into a new
+// allocation. The keyfunc is invoked exactly once per element.
+// The input slices should not be used after this call, the returned slice should be used instead.
+func SliceSortByInPlace[T any, K ordered](keyfunc func(T) K, slices ...[]T) []T {
+	result := sliceConcatReuse(slices)
+	sort.Sort(newKeyedSorter(keyfunc, result))
+	return result
+}
+
+// SliceSortStableBy returns a new slice containing the elements of all provided slices, sorted in ascending order of
+// the key returned by keyfunc. Elements with equal keys retain their original order. The keyfunc is invoked exactly
+// once per element.
+func SliceSortStableBy[T any, K ordered](keyfunc func(T) K, slices ...[]T) []T {
+	result := sliceConcatCopy(slices)
+	sort.Stable(newKeyedSorter(keyfunc, result))
+	return result
+}
+
+// SliceSortStableByInPlace sorts the elements of all provided slices in ascending order of the key returned by
+// keyfunc. The input is sorted directly when only one slice is non-empty, otherwise the elements are combined into a
+// new allocation. Elements with equal keys retain their original order.
+// The input slices should not be used after this call, the returned slice should be used instead.
+func SliceSortStableByInPlace[T any, K ordered](keyfunc func(T) K, slices ...[]T) []T {
+	result := sliceConcatReuse(slices)
+	sort.Stable(newKeyedSorter(keyfunc, result))
+	return result
+}
+
+// SortOrder describes a multi-key ordering, built with SortBy or SortByDesc and extended with ThenBy or ThenByDesc.
+type SortOrder[T any] struct {
+	keys []sortKey[T]
+}
+
+// sortKey computes the cached keys for a single level of a SortOrder.
+type sortKey[T any] interface {
+	extract(values []T) sortKeyColumn
+}
+
+// sortKeyColumn holds the cached keys for a single level of a SortOrder.
+type sortKeyColumn interface {
+	compare(i, j int) int
+	swap(i, j int)
+}
+
+type orderedSortKey[T any, K ordered] struct {
+	keyfunc func(T) K
+	desc    bool
+}
+
+func (k orderedSortKey[T, K]) extract(values []T) sortKeyColumn {
+	keys := make([]K, len(values))
+	for i, v := range values {
+		keys[i] = k.keyfunc(v)
+	}
+	return orderedKeyColumn[K]{keys: keys, desc: k.desc}
+}
+
+type orderedKeyColumn[K ordered] struct {
+	keys []K
+	desc bool
+}
+
+func (c orderedKeyColumn[K]) compare(i, j int) int {
+	var result int
+	if c.keys[i] < c.keys[j] {
+		result = -1
+	} else if c.keys[j] < c.keys[i] {
+		result = 1
+	}
+	if c.desc {
+		return -result
+	}
+	return result
+}
+
+func (c orderedKeyColumn[K]) swap(i, j int) {
+	c.keys[i], c.keys[j] = c.keys[j], c.keys[i]
+}
+
+// SortBy starts a SortOrder which orders elements ascending by the key returned by keyfunc.
+func SortBy[T any, K ordered](keyfunc func(T) K) SortOrder[T] {
+	return SortOrder[T]{keys: []sortKey[T]{orderedSortKey[T, K]{keyfunc: keyfunc}}}
+}
+
+// SortByDesc starts a SortOrder which orders elements descending by the key returned by keyfunc.
+func SortByDesc[T any, K ordered](keyfunc func(T) K) SortOrder[T] {
+	return SortOrder[T]{keys: []sortKey[T]{orderedSortKey[T, K]{keyfunc: keyfunc, desc: true}}}
+}
+
+// ThenBy returns a copy of the SortOrder which orders elements with equal prior keys ascending by the key returned
+// by keyfunc.
+func ThenBy[T any, K ordered](order SortOrder[T], keyfunc func(T) K) SortOrder[T] {
+	return order.then(orderedSortKey[T, K]{keyfunc: keyfunc})
+}
+
+// ThenByDesc returns a copy of the SortOrder which orders elements with equal prior keys descending by the key
+// returned by keyfunc.
+func ThenByDesc[T any, K ordered](order SortOrder[T], keyfunc func(T) K) SortOrder[T] {
+	return order.then(orderedSortKey[T, K]{keyfunc: keyfunc, desc: true})
+}
+
+func (o SortOrder[T]) then(key sortKey[T]) SortOrder[T] {
+	keys := make([]sortKey[T], len(o.keys), len(o.keys)+1)
+	copy(keys, o.keys)
+	return SortOrder[T]{keys: append(keys, key)}
+}
+
+// orderSorter sorts values by the cached key columns of a SortOrder.
+type orderSorter[T any] struct {
+	values  []T
+	columns []sortKeyColumn
+}
+
+func (s orderSorter[T]) Len() int {
+	return len(s.values)
+}
+
+func (s orderSorter[T]) Less(i, j int) bool {
+	for _, c := range s.columns {
+		if result := c.compare(i, j); result != 0 {
+			return result < 0
+		}
+	}
+	return false
+}
+
+func (s orderSorter[T]) Swap(i, j int) {
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+	for _, c := range s.columns {
+		c.swap(i, j)
+	}
+}
+
+func sortByOrder[T any](order SortOrder[T], values []T) {
+	columns := make([]sortKeyColumn, len(order.keys))
+	for i, k := range order.keys {
+		columns[i] = k.extract(values)
+	}
+	sort.Stable(orderSorter[T]{values: values, columns: columns})
+}
+
+// SliceSortByOrder returns a new slice containing the elements of all provided slices, sorted by the multi-key
+// SortOrder. Each key function is invoked exactly once per element. The sort is stable, elements with equal keys
+// retain their original order.
+func SliceSortByOrder[T any](order SortOrder[T], slices ...[]T) []T {
+	result := sliceConcatCopy(slices)
+	sortByOrder(order, result)
+	return result
+}
+
+// SliceSortByOrderInPlace sorts the elements of all provided slices by the multi-key SortOrder. The input is sorted
+// directly when only one slice is non-empty, otherwise the elements are combined into a new allocation. Each key
+// function is invoked exactly once per element, and the sort is stable.
+// The input slices should not be used after this call, the returned slice should be used instead.
+func SliceSortByOrderInPlace[T any](order SortOrder[T], slices ...[]T) []T {
+	result := sliceConcatReuse(slices)
+	sortByOrder(order, result)
+	return result
+}
+
+// sliceConcatCopy returns a new slice containing the elements of all slices, sized exactly, or nil if empty.
+func sliceConcatCopy[T any](slices [][]T) []T {
+	size := sliceLen(slices)
+	if size == 0 {
+		return nil
+	}
+	result := make([]T, 0, size)
+	for _, slice := range slices {
+		result = append(result, slice...)
+	}
+	return result
+}
+
+// sliceConcatReuse returns the elements of all slices for an in place operation which keeps every element. The memory
+// of an input is only reused when it is the single non-empty slice, since the combined elements cannot fit within the
+// length of any one input otherwise. Capacity beyond an input's length is never written, as it may belong to the caller.
+func sliceConcatReuse[T any](slices [][]T) []T {
+	var nonEmpty []T
+	for _, slice := range slices {
+		if len(slice) == 0 {
+			continue
+		} else if nonEmpty != nil {
+			return sliceConcatCopy(slices)
+		}
+		nonEmpty = slice
+	}
+	return nonEmpty
+}
+
+// radixInsertionThreshold is the size at or below which radix sorts use an insertion sort instead.
+const radixInsertionThreshold = 32
+
+// SliceRadixSort returns a new slice containing the elements of all provided slices sorted in ascending order,
+// using an LSD radix sort. Runs in O(n) time, allocating the result and a single scratch buffer of equal size.
+// Byte positions which are identical across all values are skipped, so narrow value ranges sort faster.
+func SliceRadixSort[T integer](slices ...[]T) []T {
+	result := sliceConcatCopy(slices)
+	radixSort(result)
+	return result
+}
+
+// SliceRadixSortInPlace sorts the elements of all provided slices in ascending order using an LSD radix sort. The
+// input is sorted directly, allocating only a single scratch buffer, when only one slice is non-empty. Otherwise the
+// elements are combined into a new allocation.
+// The input slices should not be used after this call, the returned slice should be used instead.
+func SliceRadixSortInPlace[T integer](slices ...[]T) []T {
+	result := sliceConcatReuse(slices)
+	radixSort(result)
+	return result
+}
+
+// SliceRadixSortBy returns a new slice containing the elements of all provided slices sorted in ascending order of
+// the integer key returned by keyfunc, using an LSD radix sort. The keyfunc is invoked exactly once per element, and
+// the sort is stable, elements with equal keys retain their original order.
+func SliceRadixSortBy[T any, K integer](keyfunc func(T) K, slices ...[]T) []T {
+	result := sliceConcatCopy(slices)
+	radixSortBy(keyfunc, result)
+	return result
+}
+
+// SliceRadixSortByInPlace sorts the elements of all provided slices in ascending order of the integer key returned by
+// keyfunc. The input is sorted directly when only one slice is non-empty, otherwise the elements are combined into a
+// new allocation. The keyfunc is invoked exactly once per element, and
+// the sort is stable. The input slices should not be used after this call, the returned slice should be used instead.
+func SliceRadixSortByInPlace[T any, K integer](keyfunc func(T) K, slices ...[]T) []T {
+	result := sliceConcatReuse(slices)
+	radixSortBy(keyfunc, result)
+	return result
+}
+
+// radixSignMask returns the mask which, when xor'ed with a sign extended value, maps the integer type onto uint64
+// while preserving order.
+func radixSignMask[T integer]() uint64 {
+	if ^T(0) < 0 { // signed
+		return 1 << 63
+	}
+	return 0
+}
+
+// radixCounts returns the per byte histograms for the keys, provided by the key function for each index.
+func radixCounts(n int, key func(i int) uint64) *[8][256]int {
+	var counts [8][256]int
+	for i := 0; i < n; i++ {
+		k := key(i)
+		for d := 0; d < 8; d++ {
+			counts[d][byte(k>>(8*d))]++
+		}
+	}
+	return &counts
+}
+
+// radixOffsets converts the histogram into starting offsets, returning false if the digit is identical for every
+// value and the pass can be skipped.
+func radixOffsets(counts *[256]int, n int) bool {
+	var offset int
+	for b, c := range counts {
+		if c == n {
+			return false
+		}
+		counts[b] = offset
+		offset += c
+	}
+	return true
+}
+
+func radixSort[T integer](values []T) {
+	n := len(values)
+	if n <= radixInsertionThreshold {
+		for i := 1; i < n; i++ {
+			for j := i; j > 0 && values[j] < values[j-1]; j-- {
+				values[j], values[j-1] = values[j-1], values[j]
+			}
+		}
+		return
+	}
+
+	signMask := radixSignMask[T]()
+	counts := radixCounts(n, func(i int) uint64 { return uint64(values[i]) ^ signMask })
+	src, dst := values, make([]T, n)
+	for d := 0; d < 8; d++ {
+		offsets := &counts[d]
+		if !radixOffsets(offsets, n) {
+			continue
+		}
+		shift := 8 * d
+		for _, v := range src {
+			b := byte((uint64(v) ^ signMask) >> shift)
+			dst[offsets[b]] = v
+			offsets[b]++
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &values[0] {
+		copy(values, src)
+	}
+}
+
+func radixSortBy[T any, K integer](keyfunc func(T) K, values []T) {
+	n := len(values)
+	if n == 0 {
+		return
+	}
+	signMask := radixSignMask[K]()
+	keys := make([]uint64, n)
+	for i, v := range values {
+		keys[i] = uint64(keyfunc(v)) ^ signMask
+	}
+	if n <= radixInsertionThreshold {
+		for i := 1; i < n; i++ {
+			for j := i; j > 0 && keys[j] < keys[j-1]; j-- {
+				keys[j], keys[j-1] = keys[j-1], keys[j]
+				values[j], values[j-1] = values[j-1], values[j]
+			}
+		}
+		return
+	}
+
+	counts := radixCounts(n, func(i int) uint64 { return keys[i] })
+	srcKeys, dstKeys := keys, make([]uint64, n)
+	srcValues, dstValues := values, make([]T, n)
+	for d := 0; d < 8; d++ {
+		offsets := &counts[d]
+		if !radixOffsets(offsets, n) {
+			continue
+		}
+		shift := 8 * d
+		for i, k := range srcKeys {
+			b := byte(k >> shift)
+			dstKeys[offsets[b]] = k
+			dstValues[offsets[b]] = srcValues[i]
+			offsets[b]++
+		}
+		srcKeys, dstKeys = dstKeys, srcKeys
+		srcValues, dstValues = dstValues, srcValues
+	}
+	if &srcValues[0] != &values[0] {
+		copy(values, srcValues)
+	}
+}
+
+// parallelSortMinChunk is the minimum number of elements each worker sorts in SliceSortParallel.
+const parallelSortMinChunk = 4096
+
+// SliceSortParallel returns a new slice containing the elements of all provided slices sorted by the less function.
+// Contiguous chunks are sorted concurrently by up to workers goroutines (GOMAXPROCS if workers is <= 0), then k-way
+// merged into a single scratch buffer which becomes the result. If the less function panics, the panic is raised on
+// the calling goroutine so it can be recovered by the caller. The sort is not stable, use SliceSortStableParallel if
+// the original order of equal elements must be retained.
+func SliceSortParallel[T any](less func(a, b T) bool, workers int, slices ...[]T) []T {
+	return sliceSortParallel(less, workers, false, slices)
+}
+
+// SliceSortStableParallel returns a new slice containing the elements of all provided slices sorted by the less
+// function, producing the same result as a stable sort. See SliceSortParallel for details.
+func SliceSortStableParallel[T any](less func(a, b T) bool, workers int, slices ...[]T) []T {
+	return sliceSortParallel(less, workers, true, slices)
+}
+
+func sliceSortParallel[T any](less func(a, b T) bool, workers int, stable bool, slices [][]T) []T {
+	values := sliceConcatCopy(slices)
+	sortChunk := func(chunk []T) {
+		if stable {
+			sort.SliceStable(chunk, func(i, j int) bool { return less(chunk[i], chunk[j]) })
+		} else {
+			sort.Slice(chunk, func(i, j int) bool { return less(chunk[i], chunk[j]) })
+		}
+	}
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if maxWorkers := len(values) / parallelSortMinChunk; workers > maxWorkers {
+		workers = maxWorkers
+	}
+	if workers <= 1 {
+		sortChunk(values)
+		return values
+	}
+
+	chunks := make([][]T, workers)
+	var wg sync.WaitGroup
+	var panicOnce sync.Once
+	var panicked bool
+	var panicValue interface{}
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		chunks[w] = values[w*len(values)/workers : (w+1)*len(values)/workers]
+		go func(chunk []T) {
+			defer wg.Done()
+			defer func() {
+				// a panic in a worker would crash the process, so it is raised again on the calling goroutine
+				if r := recover(); r != nil {
+					panicOnce.Do(func() {
+						panicked, panicValue = true, r
+					})
+				}
+			}()
+			sortChunk(chunk)
+		}(chunks[w])
+	}
+	wg.Wait()
+	if panicked {
+		panic(panicValue)
+	}
+
+	return sliceMergeSorted(make([]T, 0, len(values)), less, chunks)
+}
+
+// sliceMergeSorted appends the k-way merge of the sorted chunks onto dest. Equal elements are taken from the earlier
+// chunk first, so merging chunks of a stable sort produces a stable result.
+func sliceMergeSorted[T any](dest []T, less func(a, b T) bool, chunks [][]T) []T {
+	// heap holds the indexes of non-empty chunks, ordered by their first element and then by chunk index
+	heap := make([]int, 0, len(chunks))
+	before := func(a, b int) bool {
+		if less(chunks[a][0], chunks[b][0]) {
+			return true
+		} else if less(chunks[b][0], chunks[a][0]) {
+			return false
+		}
+		return a < b
+	}
+	down := func(i int) {
+		for {
+			smallest := i
+			if l := 2*i + 1; l < len(heap) && before(heap[l], heap[smallest]) {
+				smallest = l
+			}
+			if r := 2*i + 2; r < len(heap) && before(heap[r], heap[smallest]) {
+				smallest = r
+			}
+			if smallest == i {
+				return
+			}
+			heap[i], heap[smallest] = heap[smallest], heap[i]
+			i = smallest
+		}
+	}
+	for i, chunk := range chunks {
+		if len(chunk) > 0 {
+			heap = append(heap, i)
+		}
+	}
+	for i := len(heap)/2 - 1; i >= 0; i-- {
+		down(i)
+	}
+
+	for len(heap) > 1 {
+		c := heap[0]
+		dest = append(dest, chunks[c][0])
+		if chunks[c] = chunks[c][1:]; len(chunks[c]) == 0 {
+			heap[0] = heap[len(heap)-1]
+			heap = heap[:len(heap)-1]
+		}
+		down(0)
+	}
+	if len(heap) == 1 {
+		dest = append(dest, chunks[heap[0]]...)
+	}
+	return dest
+}
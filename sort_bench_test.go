@@ -0,0 +1,106 @@
+package bulk
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func benchmarkRadixInput[T integer](n int) []T {
+	rng := rand.New(rand.NewSource(42))
+	values := make([]T, n)
+	for i := range values {
+		values[i] = T(rng.Uint64())
+	}
+	return values
+}
+
+func benchmarkRadixSort[T integer](b *testing.B) {
+	for _, n := range []int{1_000, 100_000} {
+		input := benchmarkRadixInput[T](n)
+		work := make([]T, n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.Run("sort.Slice", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					copy(work, input)
+					sort.Slice(work, func(i, j int) bool { return work[i] < work[j] })
+				}
+			})
+			b.Run("SliceRadixSort", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_ = SliceRadixSort(input)
+				}
+			})
+			b.Run("SliceRadixSortInPlace", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					copy(work, input)
+					_ = SliceRadixSortInPlace(work)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkSliceRadixSort(b *testing.B) {
+	b.Run("uint32", benchmarkRadixSort[uint32])
+	b.Run("int64", benchmarkRadixSort[int64])
+}
+
+func BenchmarkSliceRadixSortBy(b *testing.B) {
+	type record struct {
+		id   int64
+		name string
+	}
+	keyfunc := func(r record) int64 { return r.id }
+
+	for _, n := range []int{1_000, 100_000} {
+		ids := benchmarkRadixInput[int64](n)
+		input := make([]record, n)
+		for i, id := range ids {
+			input[i] = record{id: id}
+		}
+		work := make([]record, n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.Run("sort.SliceStable", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					copy(work, input)
+					sort.SliceStable(work, func(i, j int) bool { return work[i].id < work[j].id })
+				}
+			})
+			b.Run("SliceSortStableBy", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_ = SliceSortStableBy(keyfunc, input)
+				}
+			})
+			b.Run("SliceRadixSortBy", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_ = SliceRadixSortBy(keyfunc, input)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkSliceSortParallel(b *testing.B) {
+	less := func(a, b int64) bool { return a < b }
+	input := benchmarkRadixInput[int64](1_000_000)
+
+	b.Run("sort.Slice", func(b *testing.B) {
+		work := make([]int64, len(input))
+		for i := 0; i < b.N; i++ {
+			copy(work, input)
+			sort.Slice(work, func(i, j int) bool { return work[i] < work[j] })
+		}
+	})
+	b.Run("SliceSortParallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = SliceSortParallel(less, 0, input)
+		}
+	})
+	b.Run("SliceSortStableParallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = SliceSortStableParallel(less, 0, input)
+		}
+	})
+}
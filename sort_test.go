@@ -0,0 +1,395 @@
+package bulk
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSortPerson struct {
+	name string
+	dept string
+	age  int
+}
+
+func TestSliceSortBy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		slices   [][]string
+		expected []string
+	}{
+		{
+			name:     "nil",
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			slices:   [][]string{{}},
+			expected: nil,
+		},
+		{
+			name:     "single",
+			slices:   [][]string{{"ccc", "a", "bb"}},
+			expected: []string{"a", "bb", "ccc"},
+		},
+		{
+			name:     "multiple",
+			slices:   [][]string{{"dddd", "a"}, {}, {"ccc", "bb"}},
+			expected: []string{"a", "bb", "ccc", "dddd"},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			var original [][]string
+			for _, s := range tt.slices {
+				original = append(original, sliceDup(s))
+			}
+			var calls int
+			keyfunc := func(s string) int {
+				calls++
+				return len(s)
+			}
+
+			assert.Equal(t, tt.expected, SliceSortBy(keyfunc, tt.slices...))
+			assert.Equal(t, len(tt.expected), calls)
+			for j := range tt.slices {
+				assert.Equal(t, original[j], tt.slices[j])
+			}
+
+			assert.Equal(t, tt.expected, SliceSortStableBy(func(s string) int { return len(s) }, tt.slices...))
+		})
+	}
+}
+
+func TestSliceSortByInPlace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("single_reuses_memory", func(t *testing.T) {
+		input := []int{5, -3, 1, -4, 2}
+		var calls int
+		result := SliceSortByInPlace(func(v int) int {
+			calls++
+			if v < 0 {
+				return -v
+			}
+			return v
+		}, input)
+
+		assert.Equal(t, []int{1, 2, -3, -4, 5}, result)
+		assert.Equal(t, &input[0], &result[0])
+		assert.Equal(t, len(input), calls)
+	})
+	t.Run("multiple", func(t *testing.T) {
+		result := SliceSortByInPlace(func(v int) int { return v }, []int{3, 1}, []int{2, 0})
+
+		assert.Equal(t, []int{0, 1, 2, 3}, result)
+	})
+	t.Run("preserves_capacity_beyond_input", func(t *testing.T) {
+		id := func(v int) int { return v }
+		buf := []int{5, 4, 3, 100, 200, 300}
+
+		assert.Equal(t, []int{1, 3, 4, 5}, SliceSortByInPlace(id, buf[:3], []int{1}))
+		assert.Equal(t, []int{100, 200, 300}, buf[3:])
+		assert.Equal(t, []int{1, 3, 4, 5}, SliceSortStableByInPlace(id, buf[:3:4], []int{1}))
+		assert.Equal(t, []int{100, 200, 300}, buf[3:])
+		assert.Equal(t, []int{1, 3, 4, 5}, SliceSortByOrderInPlace(SortBy(id), buf[:3], nil, []int{1}))
+		assert.Equal(t, []int{100, 200, 300}, buf[3:])
+	})
+	t.Run("single_non_empty_reuses_memory", func(t *testing.T) {
+		input := []int{3, 1, 2}
+		result := SliceSortByInPlace(func(v int) int { return v }, nil, input, []int{})
+
+		assert.Equal(t, []int{1, 2, 3}, result)
+		assert.Equal(t, &input[0], &result[0])
+	})
+	t.Run("stable", func(t *testing.T) {
+		input := []string{"bb", "a", "cc", "b", "aa"}
+		result := SliceSortStableByInPlace(func(s string) int { return len(s) }, input)
+
+		assert.Equal(t, []string{"a", "b", "bb", "cc", "aa"}, result)
+	})
+}
+
+func TestSliceSortStableBy(t *testing.T) {
+	t.Parallel()
+
+	input := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		input = append(input, strings.Repeat("x", i%3)+strconv.Itoa(i))
+	}
+	result := SliceSortStableBy(func(s string) int { return strings.Count(s, "x") }, input)
+
+	for i := 1; i < len(result); i++ {
+		prevX, currX := strings.Count(result[i-1], "x"), strings.Count(result[i], "x")
+		if !assert.LessOrEqual(t, prevX, currX) {
+			break
+		} else if prevX == currX {
+			prevNum, _ := strconv.Atoi(strings.TrimLeft(result[i-1], "x"))
+			currNum, _ := strconv.Atoi(strings.TrimLeft(result[i], "x"))
+			assert.Less(t, prevNum, currNum)
+		}
+	}
+}
+
+func TestSliceSortByOrder(t *testing.T) {
+	t.Parallel()
+
+	people := []testSortPerson{
+		{"alice", "eng", 30},
+		{"bob", "sales", 25},
+		{"carol", "eng", 40},
+		{"dave", "eng", 30},
+		{"erin", "sales", 35},
+	}
+	dept := func(p testSortPerson) string { return p.dept }
+	age := func(p testSortPerson) int { return p.age }
+	name := func(p testSortPerson) string { return p.name }
+	names := func(people []testSortPerson) []string {
+		return SliceTransform(func(p testSortPerson) string { return p.name }, people)
+	}
+
+	t.Run("then_by_desc", func(t *testing.T) {
+		result := SliceSortByOrder(ThenByDesc(SortBy(dept), age), people)
+
+		assert.Equal(t, []string{"carol", "alice", "dave", "erin", "bob"}, names(result))
+		assert.Equal(t, "alice", people[0].name) // input unmodified
+	})
+	t.Run("desc_then_by", func(t *testing.T) {
+		result := SliceSortByOrder(ThenBy(ThenBy(SortByDesc(dept), age), name), people)
+
+		assert.Equal(t, []string{"bob", "erin", "alice", "dave", "carol"}, names(result))
+	})
+	t.Run("stable_ties", func(t *testing.T) {
+		result := SliceSortByOrder(SortBy(age), people)
+
+		assert.Equal(t, []string{"bob", "alice", "dave", "erin", "carol"}, names(result))
+	})
+	t.Run("key_called_once", func(t *testing.T) {
+		var deptCalls, ageCalls int
+		order := ThenBy(SortBy(func(p testSortPerson) string {
+			deptCalls++
+			return p.dept
+		}), func(p testSortPerson) int {
+			ageCalls++
+			return p.age
+		})
+		SliceSortByOrder(order, people, people)
+
+		assert.Equal(t, 2*len(people), deptCalls)
+		assert.Equal(t, 2*len(people), ageCalls)
+	})
+	t.Run("then_by_does_not_alias", func(t *testing.T) {
+		base := SortBy(dept)
+		byAge := ThenBy(base, age)
+		byName := ThenByDesc(base, name)
+
+		assert.Len(t, base.keys, 1)
+		assert.Equal(t, []string{"dave", "carol", "alice", "erin", "bob"},
+			names(SliceSortByOrder(byName, people)))
+		assert.Equal(t, []string{"alice", "dave", "carol", "bob", "erin"},
+			names(SliceSortByOrder(byAge, people)))
+	})
+	t.Run("in_place", func(t *testing.T) {
+		input := sliceDup(people)
+		result := SliceSortByOrderInPlace(ThenBy(SortBy(dept), name), input)
+
+		assert.Equal(t, []string{"alice", "carol", "dave", "bob", "erin"}, names(result))
+		assert.Equal(t, &input[0], &result[0])
+	})
+	t.Run("empty", func(t *testing.T) {
+		assert.Nil(t, SliceSortByOrder(SortBy(age)))
+	})
+}
+
+func radixTestValues[T integer](rng *rand.Rand, n int) []T {
+	values := make([]T, n)
+	for i := range values {
+		values[i] = T(rng.Uint64())
+	}
+	return values
+}
+
+func assertRadixSort[T integer](t *testing.T, rng *rand.Rand) {
+	t.Helper()
+
+	for _, n := range []int{0, 1, 5, radixInsertionThreshold, radixInsertionThreshold + 1, 1000} {
+		input := radixTestValues[T](rng, n)
+		expected := sliceDup(input)
+		sort.Slice(expected, func(i, j int) bool { return expected[i] < expected[j] })
+
+		result := SliceRadixSort(input)
+		if n == 0 {
+			assert.Empty(t, result)
+			continue
+		}
+		assert.Equal(t, expected, result)
+		assert.Equal(t, expected, SliceRadixSortInPlace(sliceDup(input)))
+	}
+}
+
+func TestSliceRadixSort(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(42))
+	t.Run("int", func(t *testing.T) { assertRadixSort[int](t, rng) })
+	t.Run("int8", func(t *testing.T) { assertRadixSort[int8](t, rng) })
+	t.Run("int16", func(t *testing.T) { assertRadixSort[int16](t, rng) })
+	t.Run("int32", func(t *testing.T) { assertRadixSort[int32](t, rng) })
+	t.Run("int64", func(t *testing.T) { assertRadixSort[int64](t, rng) })
+	t.Run("uint8", func(t *testing.T) { assertRadixSort[uint8](t, rng) })
+	t.Run("uint16", func(t *testing.T) { assertRadixSort[uint16](t, rng) })
+	t.Run("uint32", func(t *testing.T) { assertRadixSort[uint32](t, rng) })
+	t.Run("uint64", func(t *testing.T) { assertRadixSort[uint64](t, rng) })
+	t.Run("uintptr", func(t *testing.T) { assertRadixSort[uintptr](t, rng) })
+	t.Run("named", func(t *testing.T) { assertRadixSort[time.Duration](t, rng) })
+
+	t.Run("extremes", func(t *testing.T) {
+		input := []int64{0, math.MaxInt64, -1, math.MinInt64, 1, math.MinInt64 + 1, math.MaxInt64 - 1}
+		input = append(input, make([]int64, radixInsertionThreshold)...)
+		result := SliceRadixSort(input)
+
+		assert.Equal(t, int64(math.MinInt64), result[0])
+		assert.Equal(t, int64(math.MinInt64+1), result[1])
+		assert.Equal(t, int64(-1), result[2])
+		assert.Equal(t, int64(math.MaxInt64), result[len(result)-1])
+		assert.True(t, sort.SliceIsSorted(result, func(i, j int) bool { return result[i] < result[j] }))
+	})
+	t.Run("multiple", func(t *testing.T) {
+		a := []uint32{5, 3, 9}
+		b := []uint32{1, 7}
+		result := SliceRadixSort(a, nil, b)
+
+		assert.Equal(t, []uint32{1, 3, 5, 7, 9}, result)
+		assert.Equal(t, []uint32{5, 3, 9}, a)
+	})
+	t.Run("in_place_preserves_capacity_beyond_input", func(t *testing.T) {
+		buf := []int{5, 4, 3, 100, 200, 300}
+
+		assert.Equal(t, []int{3, 4, 5, 7, 8, 9}, SliceRadixSortInPlace(buf[:3], []int{9, 8, 7}))
+		assert.Equal(t, []int{100, 200, 300}, buf[3:])
+		assert.Equal(t, []int{3, 4, 5, 7, 8, 9}, SliceRadixSortByInPlace(func(v int) int { return v }, buf[:3], []int{9, 8, 7}))
+		assert.Equal(t, []int{100, 200, 300}, buf[3:])
+	})
+	t.Run("in_place_reuses_memory", func(t *testing.T) {
+		input := radixTestValues[uint32](rng, 100)
+		result := SliceRadixSortInPlace(input)
+
+		assert.Equal(t, &input[0], &result[0])
+		assert.True(t, sort.SliceIsSorted(result, func(i, j int) bool { return result[i] < result[j] }))
+	})
+}
+
+func TestSliceRadixSortBy(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		key int16
+		seq int
+	}
+	rng := rand.New(rand.NewSource(42))
+
+	for _, n := range []int{0, 1, 10, 1000} {
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			input := make([]record, n)
+			for i := range input {
+				input[i] = record{key: int16(rng.Intn(64) - 32), seq: i}
+			}
+			expected := sliceDup(input)
+			sort.SliceStable(expected, func(i, j int) bool { return expected[i].key < expected[j].key })
+
+			var calls int
+			result := SliceRadixSortBy(func(r record) int16 {
+				calls++
+				return r.key
+			}, input)
+
+			if n == 0 {
+				assert.Empty(t, result)
+			} else {
+				assert.Equal(t, expected, result)
+				assert.Equal(t, expected, SliceRadixSortByInPlace(func(r record) int16 { return r.key }, input))
+			}
+			assert.Equal(t, n, calls)
+		})
+	}
+}
+
+func TestSliceSortParallel(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		key int
+		seq int
+	}
+	less := func(a, b record) bool { return a.key < b.key }
+	rng := rand.New(rand.NewSource(42))
+
+	for _, n := range []int{0, 1, 100, parallelSortMinChunk*3 + 7, 50_000} {
+		input := make([]record, n)
+		for i := range input {
+			input[i] = record{key: rng.Intn(100), seq: i}
+		}
+		original := sliceDup(input)
+		expected := sliceDup(input)
+		sort.SliceStable(expected, func(i, j int) bool { return less(expected[i], expected[j]) })
+
+		for _, workers := range []int{0, 1, 2, 3, 8} {
+			t.Run(strconv.Itoa(n)+"-"+strconv.Itoa(workers), func(t *testing.T) {
+				stableResult := SliceSortStableParallel(less, workers, input)
+				result := SliceSortParallel(less, workers, input)
+
+				if n == 0 {
+					assert.Empty(t, stableResult)
+					assert.Empty(t, result)
+					return
+				}
+				assert.Equal(t, expected, stableResult)
+				assert.Len(t, result, n)
+				assert.True(t, sort.SliceIsSorted(result, func(i, j int) bool { return less(result[i], result[j]) }))
+				assert.Equal(t, original, input)
+			})
+		}
+	}
+
+	t.Run("multiple", func(t *testing.T) {
+		a := radixTestValues[int](rng, parallelSortMinChunk)
+		b := radixTestValues[int](rng, parallelSortMinChunk+1)
+		expected := append(sliceDup(a), b...)
+		sort.Ints(expected)
+
+		assert.Equal(t, expected, SliceSortParallel(func(x, y int) bool { return x < y }, 2, a, b))
+	})
+}
+
+func TestSliceSortParallelPanic(t *testing.T) {
+	t.Parallel()
+
+	input := radixTestValues[int](rand.New(rand.NewSource(42)), 4*parallelSortMinChunk)
+	less := func(a, b int) bool {
+		if a == input[len(input)-1] || b == input[len(input)-1] {
+			panic("comparator failure")
+		}
+		return a < b
+	}
+
+	assert.PanicsWithValue(t, "comparator failure", func() { SliceSortParallel(less, 4, input) })
+	assert.PanicsWithValue(t, "comparator failure", func() { SliceSortStableParallel(less, 4, input) })
+}
+
+func TestSliceMergeSorted(t *testing.T) {
+	t.Parallel()
+
+	less := func(a, b string) bool { return a[0] < b[0] }
+	chunks := [][]string{{"a1", "c1"}, {}, {"a2", "b2", "c2"}, {"b3"}}
+
+	assert.Equal(t, []string{"a1", "a2", "b2", "b3", "c1", "c2"}, sliceMergeSorted(nil, less, chunks))
+}
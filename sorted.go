@@ -0,0 +1,145 @@
+package bulk
+
+import "sort"
+
+// SliceSearchBy returns the lower bound for key within a slice sorted ascending by keyfunc: the index of the first
+// element whose key is not less than the provided key, or len(slice) if there is no such element.
+func SliceSearchBy[T any, K ordered](keyfunc func(T) K, slice []T, key K) int {
+	return sort.Search(len(slice), func(i int) bool { return !(keyfunc(slice[i]) < key) })
+}
+
+// SliceSearchUpperBy returns the upper bound for key within a slice sorted ascending by keyfunc: the index of the
+// first element whose key is greater than the provided key, or len(slice) if there is no such element.
+func SliceSearchUpperBy[T any, K ordered](keyfunc func(T) K, slice []T, key K) int {
+	return sort.Search(len(slice), func(i int) bool { return key < keyfunc(slice[i]) })
+}
+
+// SliceEqualRange returns the range [start, end) of elements whose key equals the provided key, within a slice sorted
+// ascending by keyfunc. If no elements match, start and end are both the index where the key would be inserted.
+func SliceEqualRange[T any, K ordered](keyfunc func(T) K, slice []T, key K) (int, int) {
+	start := SliceSearchBy(keyfunc, slice, key)
+	end := start + SliceSearchUpperBy(keyfunc, slice[start:], key)
+	return start, end
+}
+
+func identity[T any](val T) T {
+	return val
+}
+
+// SortedInsert returns a new slice, allocated to the exact size, with val inserted into the sorted slice after any
+// equal elements. The input slice is not modified.
+func SortedInsert[T ordered](slice []T, val T) []T {
+	return SliceInsertAt(slice, SliceSearchUpperBy(identity[T], slice, val), val)
+}
+
+// SortedInsertInPlace inserts val into the sorted slice after any equal elements, shifting later elements in place.
+// If the slice has no spare capacity it grows by exactly one element, rather than the amortized growth of append.
+// The input slice should not be used after this call, the returned slice should be used instead.
+func SortedInsertInPlace[T ordered](slice []T, val T) []T {
+	idx := SliceSearchUpperBy(identity[T], slice, val)
+	if len(slice) == cap(slice) {
+		return SliceInsertAt(slice, idx, val)
+	}
+	slice = slice[:len(slice)+1]
+	copy(slice[idx+1:], slice[idx:])
+	slice[idx] = val
+	return slice
+}
+
+// SortedRemove returns a new slice, allocated to the exact size, with the first element equal to val removed from
+// the sorted slice. If val is not found the input slice is returned directly along with false.
+func SortedRemove[T ordered](slice []T, val T) ([]T, bool) {
+	idx := SliceSearchBy(identity[T], slice, val)
+	if idx == len(slice) || slice[idx] != val {
+		return slice, false
+	}
+	result := make([]T, len(slice)-1)
+	copy(result, slice[:idx])
+	copy(result[idx:], slice[idx+1:])
+	return result, true
+}
+
+// SortedRemoveInPlace removes the first element equal to val from the sorted slice, shifting later elements in place.
+// Returns the shortened slice and true if the value was found. The input slice should not be used after this call,
+// the returned slice should be used instead.
+func SortedRemoveInPlace[T ordered](slice []T, val T) ([]T, bool) {
+	idx := SliceSearchBy(identity[T], slice, val)
+	if idx == len(slice) || slice[idx] != val {
+		return slice, false
+	}
+	copy(slice[idx:], slice[idx+1:])
+	var zero T
+	slice[len(slice)-1] = zero // release reference for GC
+	return slice[:len(slice)-1], true
+}
+
+// SortedSet is a set of unique values stored in a sorted slice. It uses less memory than a map based set and
+// provides ordered iteration, with O(log n) lookup and O(n) insertion and removal. It is well suited to small and
+// medium sized sets. The zero value is an empty set ready for use. A SortedSet is not safe for concurrent mutation.
+type SortedSet[T ordered] struct {
+	values []T
+}
+
+// NewSortedSet returns a SortedSet containing the unique values from all provided slices.
+func NewSortedSet[T ordered](slices ...[]T) *SortedSet[T] {
+	values := sliceConcatCopy(slices)
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	var n int
+	for i, v := range values {
+		if i == 0 || values[n-1] != v {
+			values[n] = v
+			n++
+		}
+	}
+	return &SortedSet[T]{values: sliceCopy(values[:n])}
+}
+
+// Len returns the number of values in the set.
+func (s *SortedSet[T]) Len() int {
+	return len(s.values)
+}
+
+// Contains returns true if the value is in the set.
+func (s *SortedSet[T]) Contains(val T) bool {
+	idx := SliceSearchBy(identity[T], s.values, val)
+	return idx < len(s.values) && s.values[idx] == val
+}
+
+// Add inserts the value into the set, returning true if it was not already present.
+func (s *SortedSet[T]) Add(val T) bool {
+	idx := SliceSearchBy(identity[T], s.values, val)
+	if idx < len(s.values) && s.values[idx] == val {
+		return false
+	}
+	s.values = append(s.values, val)
+	copy(s.values[idx+1:], s.values[idx:])
+	s.values[idx] = val
+	return true
+}
+
+// Remove deletes the value from the set, returning true if it was present.
+func (s *SortedSet[T]) Remove(val T) bool {
+	var removed bool
+	s.values, removed = SortedRemoveInPlace(s.values, val)
+	return removed
+}
+
+// Index returns the position of the value within the sorted set, or -1 if it is not present.
+func (s *SortedSet[T]) Index(val T) int {
+	idx := SliceSearchBy(identity[T], s.values, val)
+	if idx < len(s.values) && s.values[idx] == val {
+		return idx
+	}
+	return -1
+}
+
+// View returns a read-only view of the values in ascending order. The view reflects the set only until the next
+// modification.
+func (s *SortedSet[T]) View() View[T] {
+	return newView(s.values)
+}
+
+// Values returns a new slice containing the values in ascending order, or nil if the set is empty.
+func (s *SortedSet[T]) Values() []T {
+	return sliceCopy(s.values)
+}
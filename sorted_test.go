@@ -0,0 +1,251 @@
+package bulk
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSliceSearchBy(t *testing.T) {
+	t.Parallel()
+
+	type entry struct {
+		key  int
+		name string
+	}
+	entries := []entry{{1, "a"}, {3, "b"}, {3, "c"}, {3, "d"}, {7, "e"}}
+	keyfunc := func(e entry) int { return e.key }
+
+	tests := []struct {
+		key           int
+		expectedLower int
+		expectedUpper int
+	}{
+		{key: 0, expectedLower: 0, expectedUpper: 0},
+		{key: 1, expectedLower: 0, expectedUpper: 1},
+		{key: 2, expectedLower: 1, expectedUpper: 1},
+		{key: 3, expectedLower: 1, expectedUpper: 4},
+		{key: 5, expectedLower: 4, expectedUpper: 4},
+		{key: 7, expectedLower: 4, expectedUpper: 5},
+		{key: 9, expectedLower: 5, expectedUpper: 5},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+strconv.Itoa(tt.key), func(t *testing.T) {
+			assert.Equal(t, tt.expectedLower, SliceSearchBy(keyfunc, entries, tt.key))
+			assert.Equal(t, tt.expectedUpper, SliceSearchUpperBy(keyfunc, entries, tt.key))
+
+			start, end := SliceEqualRange(keyfunc, entries, tt.key)
+			assert.Equal(t, tt.expectedLower, start)
+			assert.Equal(t, tt.expectedUpper, end)
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, 0, SliceSearchBy(keyfunc, nil, 1))
+		start, end := SliceEqualRange(keyfunc, nil, 1)
+		assert.Equal(t, 0, start)
+		assert.Equal(t, 0, end)
+	})
+}
+
+func TestSortedInsert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []int
+		val      int
+		expected []int
+	}{
+		{
+			name:     "nil",
+			val:      1,
+			expected: []int{1},
+		},
+		{
+			name:     "front",
+			input:    []int{2, 4},
+			val:      1,
+			expected: []int{1, 2, 4},
+		},
+		{
+			name:     "middle",
+			input:    []int{2, 4},
+			val:      3,
+			expected: []int{2, 3, 4},
+		},
+		{
+			name:     "end",
+			input:    []int{2, 4},
+			val:      5,
+			expected: []int{2, 4, 5},
+		},
+		{
+			name:     "duplicate",
+			input:    []int{2, 4, 4},
+			val:      4,
+			expected: []int{2, 4, 4, 4},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			original := sliceDup(tt.input)
+			result := SortedInsert(tt.input, tt.val)
+
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, len(tt.expected), cap(result))
+			assert.Equal(t, original, sliceDup(tt.input))
+
+			inPlace := SortedInsertInPlace(sliceDup(tt.input), tt.val)
+			assert.Equal(t, tt.expected, inPlace)
+			assert.Equal(t, len(tt.expected), cap(inPlace))
+		})
+	}
+
+	t.Run("in_place_uses_capacity", func(t *testing.T) {
+		input := make([]int, 3, 4)
+		copy(input, []int{1, 3, 5})
+		result := SortedInsertInPlace(input, 2)
+
+		assert.Equal(t, []int{1, 2, 3, 5}, result)
+		assert.Equal(t, &input[0], &result[0])
+	})
+}
+
+func TestSortedRemove(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		input         []string
+		val           string
+		expected      []string
+		expectedFound bool
+	}{
+		{
+			name: "nil",
+			val:  "a",
+		},
+		{
+			name:     "missing",
+			input:    []string{"a", "c"},
+			val:      "b",
+			expected: []string{"a", "c"},
+		},
+		{
+			name:     "missing_after_end",
+			input:    []string{"a", "c"},
+			val:      "d",
+			expected: []string{"a", "c"},
+		},
+		{
+			name:          "first",
+			input:         []string{"a", "b", "c"},
+			val:           "a",
+			expected:      []string{"b", "c"},
+			expectedFound: true,
+		},
+		{
+			name:          "last",
+			input:         []string{"a", "b", "c"},
+			val:           "c",
+			expected:      []string{"a", "b"},
+			expectedFound: true,
+		},
+		{
+			name:          "one_of_duplicates",
+			input:         []string{"a", "b", "b", "c"},
+			val:           "b",
+			expected:      []string{"a", "b", "c"},
+			expectedFound: true,
+		},
+		{
+			name:          "only",
+			input:         []string{"a"},
+			val:           "a",
+			expected:      []string{},
+			expectedFound: true,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			original := sliceDup(tt.input)
+			result, found := SortedRemove(tt.input, tt.val)
+
+			assert.Equal(t, tt.expectedFound, found)
+			assert.Equal(t, sliceDup(tt.expected), sliceDup(result))
+			assert.Equal(t, original, sliceDup(tt.input))
+
+			input := sliceDup(tt.input)
+			result, found = SortedRemoveInPlace(input, tt.val)
+			assert.Equal(t, tt.expectedFound, found)
+			assert.Equal(t, sliceDup(tt.expected), sliceDup(result))
+			if found {
+				assert.Equal(t, "", input[len(input)-1])
+			}
+		})
+	}
+}
+
+func TestSortedSet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("new", func(t *testing.T) {
+		set := NewSortedSet([]int{5, 1, 3, 1}, []int{3, 9})
+
+		assert.Equal(t, 4, set.Len())
+		assert.Equal(t, []int{1, 3, 5, 9}, set.Values())
+		assert.True(t, set.Contains(5))
+		assert.False(t, set.Contains(4))
+		assert.Equal(t, 2, set.Index(5))
+		assert.Equal(t, -1, set.Index(4))
+	})
+	t.Run("zero_value", func(t *testing.T) {
+		var set SortedSet[string]
+
+		assert.Equal(t, 0, set.Len())
+		assert.Nil(t, set.Values())
+		assert.False(t, set.Remove("a"))
+		assert.True(t, set.Add("b"))
+		assert.True(t, set.Add("a"))
+		assert.False(t, set.Add("b"))
+		assert.Equal(t, []string{"a", "b"}, set.Values())
+	})
+	t.Run("view", func(t *testing.T) {
+		set := NewSortedSet([]int{2, 1})
+		view := set.View()
+
+		assert.Equal(t, 2, view.Len())
+		assert.Equal(t, 1, view.At(0))
+		assert.Equal(t, []int{1, 2}, view.Clone())
+	})
+	t.Run("random", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(42))
+		set := NewSortedSet[int]()
+		expected := make(map[int]struct{})
+
+		for i := 0; i < 2000; i++ {
+			val := rng.Intn(100)
+			_, exists := expected[val]
+			if rng.Intn(3) == 0 {
+				assert.Equal(t, exists, set.Remove(val))
+				delete(expected, val)
+			} else {
+				assert.Equal(t, !exists, set.Add(val))
+				expected[val] = struct{}{}
+			}
+		}
+
+		values := MapKeysSlice(expected)
+		sort.Ints(values)
+		require.Equal(t, len(values), set.Len())
+		assert.Equal(t, values, set.Values())
+	})
+}
@@ -0,0 +1,71 @@
+package bulk
+
+// View is a read-only window over a slice which may share memory with the input of the operation that produced it.
+// Because elements can only be read, a View can be safely returned from operations which avoid copying, without the
+// risk of callers appending to or modifying the original input. Use Clone to obtain an owned, mutable copy.
+type View[T any] struct {
+	s []T
+}
+
+// Len returns the number of elements in the view.
+func (v View[T]) Len() int {
+	return len(v.s)
+}
+
+// At returns the element at index i. Panics if i is out of range.
+func (v View[T]) At(i int) T {
+	return v.s[i]
+}
+
+// Range calls fn for each element in order, stopping early if fn returns false.
+func (v View[T]) Range(fn func(i int, val T) bool) {
+	for i, val := range v.s {
+		if !fn(i, val) {
+			return
+		}
+	}
+}
+
+// Slice returns a sub-view of the elements between start (inclusive) and end (exclusive).
+// The capacity of the sub-view is limited to its length. Panics if the range is invalid.
+func (v View[T]) Slice(start, end int) View[T] {
+	return View[T]{s: v.s[start:end:end]}
+}
+
+// Clone returns a new slice containing the elements of the view, sized exactly to the view length.
+// Returns nil if the view is empty.
+func (v View[T]) Clone() []T {
+	return sliceCopy(v.s)
+}
+
+// newView wraps the slice as a view, limiting capacity so the underlying memory beyond the view is never exposed.
+func newView[T any](slice []T) View[T] {
+	return View[T]{s: slice[:len(slice):len(slice)]}
+}
+
+// SliceFilterView returns a read-only view of elements that pass the predicate function.
+// Performs the same allocation avoidance as SliceFilter, while preventing modification of the result.
+func SliceFilterView[T any](predicate func(val T) bool, slices ...[]T) View[T] {
+	return newView(SliceFilter(predicate, slices...))
+}
+
+// SliceSplitView partitions elements based on the predicate function into read-only views.
+// Performs the same allocation avoidance as SliceSplit, while preventing modification of the results.
+// Returns (trueElements, falseElements).
+func SliceSplitView[T any](predicate func(val T) bool, slices ...[]T) (View[T], View[T]) {
+	trueResult, falseResult := SliceSplit(predicate, slices...)
+	return newView(trueResult), newView(falseResult)
+}
+
+// SliceIntersectView returns a read-only view of elements that exist in both slices, preserving order from the first
+// slice. Performs the same allocation avoidance as SliceIntersect, while preventing modification of the result.
+func SliceIntersectView[T comparable](a, b []T) View[T] {
+	return newView(SliceIntersect(a, b))
+}
+
+// SliceDifferenceView returns a read-only view of elements that exist in the first slice but not in the second,
+// preserving order from the first slice. Performs the same allocation avoidance as SliceDifference, while preventing
+// modification of the result.
+func SliceDifferenceView[T comparable](a, b []T) View[T] {
+	return newView(SliceDifference(a, b))
+}
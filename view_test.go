@@ -0,0 +1,151 @@
+package bulk
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func viewValues[T any](v View[T]) []T {
+	var result []T
+	v.Range(func(_ int, val T) bool {
+		result = append(result, val)
+		return true
+	})
+	return result
+}
+
+func TestView(t *testing.T) {
+	t.Parallel()
+
+	input := []int{1, 2, 3, 4, 5}
+	v := newView(input)
+
+	t.Run("len_at", func(t *testing.T) {
+		assert.Equal(t, 5, v.Len())
+		assert.Equal(t, 1, v.At(0))
+		assert.Equal(t, 5, v.At(4))
+		assert.Panics(t, func() { v.At(5) })
+	})
+
+	t.Run("range", func(t *testing.T) {
+		var indexes []int
+		v.Range(func(i int, val int) bool {
+			indexes = append(indexes, i)
+			assert.Equal(t, input[i], val)
+			return true
+		})
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, indexes)
+	})
+
+	t.Run("range_stop", func(t *testing.T) {
+		var count int
+		v.Range(func(i int, val int) bool {
+			count++
+			return val < 2
+		})
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		sub := v.Slice(1, 3)
+		assert.Equal(t, 2, sub.Len())
+		assert.Equal(t, []int{2, 3}, viewValues(sub))
+		assert.Equal(t, []int{3}, viewValues(sub.Slice(1, 2)))
+		assert.Panics(t, func() { sub.Slice(0, 3) })
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		clone := v.Slice(1, 4).Clone()
+		require.Equal(t, []int{2, 3, 4}, clone)
+		assert.Equal(t, 3, cap(clone))
+		clone[0] = 100
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, input)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		var empty View[int]
+		assert.Equal(t, 0, empty.Len())
+		assert.Nil(t, empty.Clone())
+		assert.Empty(t, viewValues(empty))
+	})
+}
+
+func TestSliceFilterView(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceTestCases {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			v := SliceFilterView(tt.testFunc, tt.input)
+			if len(tt.expectTrue) == 0 {
+				assert.Equal(t, 0, v.Len())
+			} else {
+				assert.Equal(t, tt.expectTrue, viewValues(v))
+			}
+			assert.Equal(t, v.Len(), cap(v.s))
+		})
+	}
+
+	for i, tt := range sliceMultipleTestCases {
+		t.Run("multi-"+strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			v := SliceFilterView(tt.testFunc, tt.slices...)
+			if len(tt.expectTrue) == 0 {
+				assert.Equal(t, 0, v.Len())
+			} else {
+				assert.Equal(t, tt.expectTrue, viewValues(v))
+			}
+		})
+	}
+}
+
+func TestSliceSplitView(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceTestCases {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			trueView, falseView := SliceSplitView(tt.testFunc, tt.input)
+			if len(tt.expectTrue) == 0 {
+				assert.Equal(t, 0, trueView.Len())
+			} else {
+				assert.Equal(t, tt.expectTrue, viewValues(trueView))
+			}
+			if len(tt.expectFalse) == 0 {
+				assert.Equal(t, 0, falseView.Len())
+			} else {
+				assert.Equal(t, tt.expectFalse, viewValues(falseView))
+			}
+		})
+	}
+}
+
+func TestSliceIntersectView(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceSetOperationTests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			v := SliceIntersectView(tt.sliceA, tt.sliceB)
+			if len(tt.expectedIntersect) == 0 {
+				assert.Equal(t, 0, v.Len())
+			} else {
+				assert.Equal(t, tt.expectedIntersect, viewValues(v))
+			}
+		})
+	}
+}
+
+func TestSliceDifferenceView(t *testing.T) {
+	t.Parallel()
+
+	for i, tt := range sliceSetOperationTests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			v := SliceDifferenceView(tt.sliceA, tt.sliceB)
+			if len(tt.expectedDifference) == 0 {
+				assert.Equal(t, 0, v.Len())
+			} else {
+				assert.Equal(t, tt.expectedDifference, viewValues(v))
+			}
+		})
+	}
+}